@@ -0,0 +1,46 @@
+package applications
+
+import (
+	"github.com/MrAndreID/goapi/configs"
+	"github.com/MrAndreID/goapi/internal/repositories"
+	"github.com/MrAndreID/goapi/internal/services"
+)
+
+type Container struct {
+	Config          *configs.Config
+	HealthService   services.IHealthService
+	UserRepository  repositories.IUserRepository
+	UserService     services.IUserService
+	EmailRepository repositories.IEmailRepository
+	EmailService    services.IEmailService
+}
+
+func NewContainer(app *Application) *Container {
+	userRepository := repositories.NewUserRepository(app.TimeLocation, app.Database, app.Config.DatabaseFullTextSearch)
+
+	userService := services.NewUserService(userRepository, services.UserServiceOptions{
+		BulkMaxItems:    app.Config.BulkMaxItems,
+		EmailPolicy:     app.EmailPolicy,
+		Notifier:        app.NotifierQueue,
+		AppKey:          app.Config.AppKey,
+		VerificationTTL: app.Config.EmailVerificationTTL,
+	})
+
+	emailRepository := repositories.NewEmailRepository(app.TimeLocation, app.Database)
+
+	emailService := services.NewEmailService(emailRepository, services.EmailServiceOptions{
+		EmailPolicy:     app.EmailPolicy,
+		Notifier:        app.Notifier,
+		AppKey:          app.Config.AppKey,
+		VerificationTTL: app.Config.EmailVerificationTTL,
+	})
+
+	return &Container{
+		Config:          app.Config,
+		HealthService:   services.NewHealthService(app.HealthChecks(), app.Config.HealthCheckTimeout),
+		UserRepository:  userRepository,
+		UserService:     userService,
+		EmailRepository: emailRepository,
+		EmailService:    emailService,
+	}
+}
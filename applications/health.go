@@ -0,0 +1,75 @@
+package applications
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MrAndreID/goapi/databases"
+	"github.com/MrAndreID/goapi/internal/services"
+)
+
+func (app *Application) HealthChecks() []services.HealthCheck {
+	var checks []services.HealthCheck
+
+	if app.Config.UseDatabase {
+		checks = append(checks, services.HealthCheck{
+			Name:      "database",
+			Optional:  app.Config.DatabaseOptional,
+			Connected: app.Database != nil,
+			Ping: func(ctx context.Context) error {
+				if app.Database == nil {
+					return errors.New("Database Is Not Connected")
+				}
+
+				return databases.Ping(ctx, app.Database)
+			},
+		})
+	}
+
+	if app.Config.UseCache {
+		checks = append(checks, services.HealthCheck{
+			Name:      "cache",
+			Optional:  app.Config.CacheOptional,
+			Connected: app.Cache != nil,
+			Ping: func(ctx context.Context) error {
+				if app.Cache == nil {
+					return errors.New("Cache Is Not Connected")
+				}
+
+				return app.Cache.Ping(ctx)
+			},
+		})
+	}
+
+	if app.Config.UseObjectStorage {
+		checks = append(checks, services.HealthCheck{
+			Name:      "objectStorage",
+			Optional:  app.Config.ObjectStorageOptional,
+			Connected: app.ObjectStorage != nil,
+			Ping: func(ctx context.Context) error {
+				if app.ObjectStorage == nil {
+					return errors.New("Object Storage Is Not Connected")
+				}
+
+				return app.ObjectStorage.Ping(ctx)
+			},
+		})
+	}
+
+	if app.Config.UseMessageBroker {
+		checks = append(checks, services.HealthCheck{
+			Name:      "messageBroker",
+			Optional:  app.Config.MessageBrokerOptional,
+			Connected: app.MessageBroker != nil,
+			Ping: func(ctx context.Context) error {
+				if app.MessageBroker == nil {
+					return errors.New("Message Broker Is Not Connected")
+				}
+
+				return app.MessageBroker.Ping(ctx)
+			},
+		})
+	}
+
+	return checks
+}
@@ -0,0 +1,138 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/MrAndreID/goapi/databases"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+func (app *Application) Run(e *echo.Echo) error {
+	var tag string = "Applications.Lifecycle.Run."
+
+	defer app.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	defer stop()
+
+	serverError := make(chan error, 1)
+
+	go func() {
+		if err := e.Start(":" + app.Config.AppPort); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverError <- err
+		}
+
+		close(serverError)
+	}()
+
+	select {
+	case err, ok := <-serverError:
+		if ok {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to start server")
+
+			return err
+		}
+
+		return nil
+	case <-ctx.Done():
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"tag":     tag + "02",
+		"timeout": app.Config.AppShutdownTimeout.String(),
+	}).Info("shutting down server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), app.Config.AppShutdownTimeout)
+
+	defer cancel()
+
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to shut down server gracefully")
+
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"tag": tag + "04",
+	}).Info("server has been shut down")
+
+	return nil
+}
+
+func (app *Application) Close() {
+	var tag string = "Applications.Lifecycle.Close."
+
+	if app.MessageBroker != nil {
+		if err := app.MessageBroker.Close(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to close message broker")
+		} else {
+			logrus.WithFields(logrus.Fields{
+				"tag": tag + "02",
+			}).Info("message broker has been closed")
+		}
+	}
+
+	if app.ObjectStorage != nil {
+		if err := app.ObjectStorage.Close(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": err.Error(),
+			}).Error("failed to close object storage")
+		} else {
+			logrus.WithFields(logrus.Fields{
+				"tag": tag + "04",
+			}).Info("object storage has been closed")
+		}
+	}
+
+	if app.Cache != nil {
+		if err := app.Cache.Close(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "05",
+				"error": err.Error(),
+			}).Error("failed to close cache")
+		} else {
+			logrus.WithFields(logrus.Fields{
+				"tag": tag + "06",
+			}).Info("cache has been closed")
+		}
+	}
+
+	if app.Database != nil {
+		if err := databases.Close(app.Database); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "07",
+				"error": err.Error(),
+			}).Error("failed to close database")
+		} else {
+			logrus.WithFields(logrus.Fields{
+				"tag": tag + "08",
+			}).Info("database has been closed")
+		}
+	}
+
+	if app.NotifierQueue != nil {
+		app.NotifierQueue.Close()
+
+		logrus.WithFields(logrus.Fields{
+			"tag": tag + "09",
+		}).Info("notifier queue has been drained")
+	}
+}
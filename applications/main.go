@@ -9,8 +9,10 @@ import (
 	"github.com/MrAndreID/goapi/caches"
 	"github.com/MrAndreID/goapi/configs"
 	"github.com/MrAndreID/goapi/databases"
+	"github.com/MrAndreID/goapi/emails"
 	"github.com/MrAndreID/goapi/internal/handlers"
 	"github.com/MrAndreID/goapi/messagebrokers"
+	"github.com/MrAndreID/goapi/notifiers"
 	"github.com/MrAndreID/goapi/objectstorages"
 
 	"github.com/MrAndreID/gomiddleware"
@@ -27,13 +29,23 @@ import (
 type Application struct {
 	Config        *configs.Config
 	TimeLocation  *time.Location
+	EmailPolicy   *emails.Policy
+	Notifier      notifiers.INotifier
+	NotifierQueue *notifiers.QueueNotifier
 	Database      *gorm.DB
 	Cache         *caches.CacheConnection
 	ObjectStorage *objectstorages.ObjectStorageConnection
 	MessageBroker *messagebrokers.MessageBrokerConnection
 }
 
-func Start(toggle bool) any {
+type Server struct {
+	Application *Application
+	Container   *Container
+	Echo        *echo.Echo
+	Group       *echo.Group
+}
+
+func Start(toggle bool) (*Server, error) {
 	var tag string = "Applications.Main.New."
 
 	cfg, err := configs.New(toggle)
@@ -44,7 +56,7 @@ func Start(toggle bool) any {
 			"error": err.Error(),
 		}).Error("failed to initiate configuration")
 
-		return nil
+		return nil, err
 	}
 
 	timeLocation, err := time.LoadLocation(cfg.AppLocation)
@@ -55,13 +67,50 @@ func Start(toggle bool) any {
 			"error": err.Error(),
 		}).Error("failed to load location for time")
 
-		return nil
+		return nil, err
+	}
+
+	emailPolicy, err := emails.New(cfg.EmailPolicy)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "13",
+			"error": err.Error(),
+		}).Error("failed to load email policy")
+
+		return nil, err
+	}
+
+	notifier, err := notifiers.New(&notifiers.Notifier{
+		Connection: cfg.NotifierConnection,
+		Host:       cfg.NotifierHost,
+		Port:       cfg.NotifierPort,
+		Username:   cfg.NotifierUsername,
+		Password:   cfg.NotifierPassword,
+		From:       cfg.NotifierFrom,
+		Timeout:    cfg.NotifierTimeout,
+		Debug:      cfg.AppDebug,
+	})
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "14",
+			"error": err.Error(),
+		}).Error("failed to initiate notifier")
+
+		return nil, err
 	}
 
-	var databaseConnection *gorm.DB
+	app := &Application{
+		Config:        cfg,
+		TimeLocation:  timeLocation,
+		EmailPolicy:   emailPolicy,
+		Notifier:      notifier,
+		NotifierQueue: notifiers.NewQueueNotifier(notifier, cfg.NotifierQueueSize, cfg.NotifierQueueWorkers),
+	}
 
 	if cfg.UseDatabase {
-		databaseConnection, err = databases.New(&databases.Database{
+		app.Database, err = databases.New(&databases.Database{
 			Connection: cfg.DatabaseConnection,
 			Host:       cfg.DatabaseHost,
 			Port:       cfg.DatabasePort,
@@ -72,65 +121,83 @@ func Start(toggle bool) any {
 			ParseTime:  cfg.DatabaseParseTime,
 			Charset:    cfg.DatabaseCharset,
 			Timezone:   cfg.DatabaseTimezone,
+			Retry:      cfg.DatabaseRetry,
 		}, cfg.AppDebug)
 
-		if err != nil {
+		if err != nil && cfg.DatabaseOptional {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "09",
+				"error": err.Error(),
+			}).Warn("failed to connect database, starting in degraded mode")
+		} else if err != nil {
 			logrus.WithFields(logrus.Fields{
 				"tag":   tag + "03",
 				"error": err.Error(),
 			}).Error("failed to connect database")
 
-			return nil
+			app.Close()
+
+			return nil, err
 		}
 	}
 
-	var cacheConnection *caches.CacheConnection
-
 	if cfg.UseCache {
-		cacheConnection, err = caches.New(&caches.Cache{
+		app.Cache, err = caches.New(&caches.Cache{
 			Connection: cfg.CacheConnection,
 			Host:       cfg.CacheHost,
 			Port:       cfg.CachePort,
 			Username:   cfg.CacheUsername,
 			Password:   cfg.CachePassword,
+			Retry:      cfg.CacheRetry,
 		})
 
-		if err != nil {
+		if err != nil && cfg.CacheOptional {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "10",
+				"error": err.Error(),
+			}).Warn("failed to connect cache, starting in degraded mode")
+		} else if err != nil {
 			logrus.WithFields(logrus.Fields{
 				"tag":   tag + "04",
 				"error": err.Error(),
 			}).Error("failed to connect cache")
 
-			return nil
+			app.Close()
+
+			return nil, err
 		}
 	}
 
-	var objectStorageConnection *objectstorages.ObjectStorageConnection
-
 	if cfg.UseObjectStorage {
-		objectStorageConnection, err = objectstorages.New(&objectstorages.ObjectStorage{
+		app.ObjectStorage, err = objectstorages.New(&objectstorages.ObjectStorage{
 			Connection: cfg.ObjectStorageConnection,
 			Host:       cfg.ObjectStorageHost,
 			Port:       cfg.ObjectStoragePort,
 			Username:   cfg.ObjectStorageUsername,
 			Password:   cfg.ObjectStoragePassword,
 			SSL:        cfg.ObjectStorageSSL,
+			Retry:      cfg.ObjectStorageRetry,
 		})
 
-		if err != nil {
+		if err != nil && cfg.ObjectStorageOptional {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "11",
+				"error": err.Error(),
+			}).Warn("failed to connect object storage, starting in degraded mode")
+		} else if err != nil {
 			logrus.WithFields(logrus.Fields{
 				"tag":   tag + "05",
 				"error": err.Error(),
 			}).Error("failed to connect object storage")
 
-			return nil
+			app.Close()
+
+			return nil, err
 		}
 	}
 
-	var messageBrokerConnection *messagebrokers.MessageBrokerConnection
-
 	if cfg.UseMessageBroker {
-		messageBrokerConnection, err = messagebrokers.New(&messagebrokers.MessageBroker{
+		app.MessageBroker, err = messagebrokers.New(&messagebrokers.MessageBroker{
 			Connection: cfg.MessageBrokerConnection,
 			Host:       cfg.MessageBrokerHost,
 			Port:       cfg.MessageBrokerPort,
@@ -138,27 +205,24 @@ func Start(toggle bool) any {
 			Password:   cfg.MessageBrokerPassword,
 			Name:       cfg.MessageBrokerName,
 			Partition:  cfg.MessageBrokerPartition,
+			Retry:      cfg.MessageBrokerRetry,
 		})
 
-		if err != nil {
+		if err != nil && cfg.MessageBrokerOptional {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "12",
+				"error": err.Error(),
+			}).Warn("failed to connect message broker, starting in degraded mode")
+		} else if err != nil {
 			logrus.WithFields(logrus.Fields{
 				"tag":   tag + "06",
 				"error": err.Error(),
 			}).Error("failed to connect message broker")
 
-			return nil
-		}
-	}
-
-	defer messageBrokerConnection.Close()
+			app.Close()
 
-	app := Application{
-		Config:        cfg,
-		TimeLocation:  timeLocation,
-		Database:      databaseConnection,
-		Cache:         cacheConnection,
-		ObjectStorage: objectStorageConnection,
-		MessageBroker: messageBrokerConnection,
+			return nil, err
+		}
 	}
 
 	echo.NotFoundHandler = func(c echo.Context) error {
@@ -199,29 +263,34 @@ func Start(toggle bool) any {
 
 	e.Use(middleware.Recover())
 
-	e.Use(middleware.BodyDump(func(c echo.Context, requestBody, responseBody []byte) {
-		request := struct {
-			Header interface{} `json:"header"`
-			Body   string      `json:"body"`
-		}{
-			Header: c.Request().Header,
-			Body:   string(requestBody),
-		}
-
-		response := struct {
-			Header interface{} `json:"header"`
-			Body   string      `json:"body"`
-		}{
-			Header: c.Response().Header(),
-			Body:   string(responseBody),
-		}
+	e.Use(middleware.BodyDumpWithConfig(middleware.BodyDumpConfig{
+		Skipper: func(c echo.Context) bool {
+			return strings.HasSuffix(c.Path(), "/export")
+		},
+		Handler: func(c echo.Context, requestBody, responseBody []byte) {
+			request := struct {
+				Header interface{} `json:"header"`
+				Body   string      `json:"body"`
+			}{
+				Header: c.Request().Header,
+				Body:   string(requestBody),
+			}
+
+			response := struct {
+				Header interface{} `json:"header"`
+				Body   string      `json:"body"`
+			}{
+				Header: c.Response().Header(),
+				Body:   string(responseBody),
+			}
 
-		logrus.WithFields(logrus.Fields{
-			"request":   request,
-			"requestId": c.Get("RequestID"),
-			"response":  response,
-			"url":       c.Request().Host + c.Request().URL.String(),
-		}).Info("body dump")
+			logrus.WithFields(logrus.Fields{
+				"request":   request,
+				"requestId": c.Get("RequestID"),
+				"response":  response,
+				"url":       c.Request().Host + c.Request().URL.String(),
+			}).Info("body dump")
+		},
 	}))
 
 	e.Use(middleware.SecureWithConfig(middleware.SecureConfig{
@@ -261,17 +330,48 @@ func Start(toggle bool) any {
 		e.Debug = true
 	}
 
-	initService(&app)
+	container := NewContainer(app)
+
+	handlers.NewHealthHandler(e, container.HealthService)
 
 	api := e.Group("/api")
 
 	v1 := api.Group("/v1")
 
+	server := &Server{
+		Application: app,
+		Container:   container,
+		Echo:        e,
+		Group:       v1,
+	}
+
 	if toggle {
-		handlers.NewUserHandler(v1, UserService)
+		for _, module := range Modules() {
+			var middlewares []echo.MiddlewareFunc
+
+			if app.Database == nil && len(module.Models()) > 0 {
+				logrus.WithFields(logrus.Fields{
+					"tag":    tag + "15",
+					"module": module.Name(),
+				}).Warn("database is unavailable, module routes respond with service unavailable")
+
+				middlewares = append(middlewares, serviceUnavailable)
+			}
 
-		return e.Start(":" + cfg.AppPort)
+			module.Routes(v1, container, middlewares...)
+		}
+
+		return server, app.Run(e)
 	}
 
-	return v1
+	return server, nil
+}
+
+func serviceUnavailable(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{
+			"code":        fmt.Sprintf("%04d", http.StatusServiceUnavailable),
+			"description": strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusServiceUnavailable), " ", "_")),
+		})
+	}
 }
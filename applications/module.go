@@ -0,0 +1,33 @@
+package applications
+
+import (
+	"io/fs"
+	"math/rand/v2"
+
+	"github.com/MrAndreID/goapi/databases/migrator"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+type Module interface {
+	Name() string
+	Models() []any
+	Migrations() []migrator.Migration
+	Routes(*echo.Group, *Container, ...echo.MiddlewareFunc)
+	Seed(*gorm.DB, fs.FS) error
+}
+
+type FakeSeeder interface {
+	SeedFake(*gorm.DB, int, *rand.Rand) error
+}
+
+var modules []Module
+
+func Register(module Module) {
+	modules = append(modules, module)
+}
+
+func Modules() []Module {
+	return modules
+}
@@ -0,0 +1,60 @@
+package applications
+
+import (
+	"io/fs"
+
+	"github.com/MrAndreID/goapi/databases/fixtures"
+	"github.com/MrAndreID/goapi/databases/models"
+	"github.com/MrAndreID/goapi/internal/handlers"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type UserModule struct{}
+
+func init() {
+	Register(UserModule{})
+}
+
+func (UserModule) Name() string {
+	return "user"
+}
+
+func (UserModule) Models() []any {
+	return []any{
+		&models.User{},
+		&models.Email{},
+	}
+}
+
+func (UserModule) Routes(group *echo.Group, container *Container, middlewares ...echo.MiddlewareFunc) {
+	cache := handlers.CachePolicy{
+		CacheControl: container.Config.HTTPCacheControl,
+		Routes:       container.Config.HTTPCacheControlRoutes,
+	}
+
+	handlers.NewUserHandler(group, container.UserService, handlers.UserHandlerOptions{
+		RequireIfMatch: container.Config.StrictConcurrency,
+		Cache:          cache,
+		Middlewares:    middlewares,
+	})
+	handlers.NewEmailHandler(group, container.EmailService, handlers.EmailHandlerOptions{
+		Cache:       cache,
+		Middlewares: middlewares,
+	})
+}
+
+func (UserModule) Seed(db *gorm.DB, fsys fs.FS) error {
+	if err := fixtures.Load(db, fsys, UserModule{}.Models()...); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Applications.User.Seed.01",
+			"error": err.Error(),
+		}).Error("failed to load user fixtures")
+
+		return err
+	}
+
+	return nil
+}
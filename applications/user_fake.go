@@ -0,0 +1,134 @@
+package applications
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/MrAndreID/goapi/databases/models"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const fakeBatchSize int = 1000
+
+var fakeBaseTime time.Time = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	fakeFirstNames []string = []string{
+		"Andrea", "Zelda", "Budi", "Siti", "Agus", "Dewi", "Rizky", "Putri", "Joko", "Ayu",
+		"John", "Jane", "Michael", "Emily", "David", "Sarah", "James", "Olivia", "Daniel", "Sophia",
+	}
+	fakeLastNames []string = []string{
+		"Adam", "Skyward", "Santoso", "Rahmawati", "Wijaya", "Lestari", "Pratama", "Hidayat", "Kurniawan", "Saputra",
+		"Smith", "Johnson", "Williams", "Brown", "Jones", "Miller", "Davis", "Wilson", "Taylor", "Anderson",
+	}
+	fakeDomains []string = []string{
+		"example.com", "example.net", "example.org", "mail.test", "company.test",
+	}
+)
+
+func (UserModule) SeedFake(db *gorm.DB, count int, rng *rand.Rand) error {
+	var tag string = "Applications.UserFake.SeedFake."
+
+	for offset := 0; offset < count; offset += fakeBatchSize {
+		size := min(fakeBatchSize, count-offset)
+
+		users := make([]models.User, 0, size)
+		emails := make([]models.Email, 0, size*2)
+		ids := make([]string, 0, size)
+
+		for i := 0; i < size; i++ {
+			userUUID, err := uuid.NewRandomFromReader(rngReader{rng})
+
+			if err != nil {
+				return err
+			}
+
+			firstName := fakeFirstNames[rng.IntN(len(fakeFirstNames))]
+			lastName := fakeLastNames[rng.IntN(len(fakeLastNames))]
+			createdAt := fakeBaseTime.Add(-time.Duration(rng.Int64N(int64(2 * 365 * 24 * time.Hour))))
+
+			user := models.User{
+				ID:        userUUID.String(),
+				CreatedAt: createdAt,
+				UpdatedAt: createdAt,
+				Name:      firstName + " " + lastName,
+				Version:   1,
+			}
+
+			emailCount := 1 + rng.IntN(3)
+
+			for j := 0; j < emailCount; j++ {
+				emailUUID, err := uuid.NewRandomFromReader(rngReader{rng})
+
+				if err != nil {
+					return err
+				}
+
+				emails = append(emails, models.Email{
+					ID:        emailUUID.String(),
+					CreatedAt: createdAt,
+					UpdatedAt: createdAt,
+					UserID:    user.ID,
+					Email:     fmt.Sprintf("%s.%s.%s.%d.%d@%s", strings.ToLower(firstName), strings.ToLower(lastName), strings.ReplaceAll(user.ID, "-", ""), offset+i, j, fakeDomains[rng.IntN(len(fakeDomains))]),
+					Primary:   j == 0,
+				})
+			}
+
+			users = append(users, user)
+			ids = append(ids, user.ID)
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "id"}}, DoNothing: true}).CreateInBatches(&users, fakeBatchSize).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "id"}}, DoNothing: true}).CreateInBatches(&emails, fakeBatchSize).Error; err != nil {
+				return err
+			}
+
+			var missing int64
+
+			if err := tx.Model(&models.User{}).Where("id IN ?", ids).Where("NOT EXISTS (SELECT 1 FROM emails WHERE emails.user_id = users.id AND emails.deleted_at IS NULL)").Count(&missing).Error; err != nil {
+				return err
+			}
+
+			if missing > 0 {
+				return fmt.Errorf("%d Fake Users Have No Emails", missing)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to create fake user data")
+
+			return err
+		}
+
+		fmt.Printf("Seeded: %d/%d Fake Users\n", offset+size, count)
+	}
+
+	return nil
+}
+
+type rngReader struct {
+	rng *rand.Rand
+}
+
+func (r rngReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r.rng.Uint32())
+	}
+
+	return len(p), nil
+}
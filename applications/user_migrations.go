@@ -0,0 +1,272 @@
+package applications
+
+import (
+	"time"
+
+	"github.com/MrAndreID/goapi/databases/migrator"
+
+	"gorm.io/gorm"
+)
+
+func (UserModule) Migrations() []migrator.Migration {
+	return []migrator.Migration{
+		{
+			Version: 20261017000001,
+			Name:    "create_users_table",
+			Up: func(tx *gorm.DB) error {
+				type User struct {
+					ID        string         `gorm:"primaryKey;Column:id;type:varchar(45)"`
+					CreatedAt time.Time      `gorm:"Column:created_at;type:timestamptz;not null"`
+					UpdatedAt time.Time      `gorm:"Column:updated_at;type:timestamptz;not null"`
+					DeletedAt gorm.DeletedAt `gorm:"Column:deleted_at;type:timestamptz"`
+					Name      string         `gorm:"Column:name;type:varchar(255);not null"`
+				}
+
+				if tx.Migrator().HasTable("users") {
+					return nil
+				}
+
+				return tx.Table("users").Migrator().CreateTable(&User{})
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable("users")
+			},
+		},
+		{
+			Version: 20261017000002,
+			Name:    "create_emails_table",
+			Up: func(tx *gorm.DB) error {
+				type Email struct {
+					ID        string         `gorm:"primaryKey;Column:id;type:varchar(45)"`
+					CreatedAt time.Time      `gorm:"Column:created_at;type:timestamptz;not null"`
+					UpdatedAt time.Time      `gorm:"Column:updated_at;type:timestamptz;not null"`
+					DeletedAt gorm.DeletedAt `gorm:"Column:deleted_at;type:timestamptz"`
+					UserID    string         `gorm:"Column:user_id;type:varchar(45);not null"`
+					Email     string         `gorm:"Column:email;type:varchar(255);not null"`
+				}
+
+				if tx.Migrator().HasTable("emails") {
+					return nil
+				}
+
+				return tx.Table("emails").Migrator().CreateTable(&Email{})
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Migrator().DropTable("emails")
+			},
+		},
+		{
+			Version: 20261017000003,
+			Name:    "add_full_text_search_indexes",
+			Up: func(tx *gorm.DB) error {
+				switch tx.Dialector.Name() {
+				case "postgres":
+					for _, statement := range []string{
+						"ALTER TABLE users ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce(name, ''))) STORED",
+						"CREATE INDEX IF NOT EXISTS idx_users_search_vector ON users USING GIN (search_vector)",
+						"ALTER TABLE emails ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce(email, ''))) STORED",
+						"CREATE INDEX IF NOT EXISTS idx_emails_search_vector ON emails USING GIN (search_vector)",
+					} {
+						if err := tx.Exec(statement).Error; err != nil {
+							return err
+						}
+					}
+				case "mysql":
+					if !tx.Migrator().HasIndex("users", "idx_users_name_fulltext") {
+						if err := tx.Exec("ALTER TABLE users ADD FULLTEXT INDEX idx_users_name_fulltext (name)").Error; err != nil {
+							return err
+						}
+					}
+
+					if !tx.Migrator().HasIndex("emails", "idx_emails_email_fulltext") {
+						if err := tx.Exec("ALTER TABLE emails ADD FULLTEXT INDEX idx_emails_email_fulltext (email)").Error; err != nil {
+							return err
+						}
+					}
+				}
+
+				return nil
+			},
+			Down: func(tx *gorm.DB) error {
+				switch tx.Dialector.Name() {
+				case "postgres":
+					for _, statement := range []string{
+						"DROP INDEX IF EXISTS idx_emails_search_vector",
+						"ALTER TABLE emails DROP COLUMN IF EXISTS search_vector",
+						"DROP INDEX IF EXISTS idx_users_search_vector",
+						"ALTER TABLE users DROP COLUMN IF EXISTS search_vector",
+					} {
+						if err := tx.Exec(statement).Error; err != nil {
+							return err
+						}
+					}
+				case "mysql":
+					if tx.Migrator().HasIndex("emails", "idx_emails_email_fulltext") {
+						if err := tx.Migrator().DropIndex("emails", "idx_emails_email_fulltext"); err != nil {
+							return err
+						}
+					}
+
+					if tx.Migrator().HasIndex("users", "idx_users_name_fulltext") {
+						if err := tx.Migrator().DropIndex("users", "idx_users_name_fulltext"); err != nil {
+							return err
+						}
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			Version: 20261017000004,
+			Name:    "add_primary_to_emails_table",
+			Up: func(tx *gorm.DB) error {
+				type Email struct {
+					Primary bool `gorm:"Column:is_primary;not null;default:false"`
+				}
+
+				if !tx.Migrator().HasColumn(&Email{}, "is_primary") {
+					if err := tx.Table("emails").Migrator().AddColumn(&Email{}, "Primary"); err != nil {
+						return err
+					}
+				}
+
+				var statements []string
+
+				switch tx.Dialector.Name() {
+				case "postgres":
+					statements = []string{
+						"UPDATE emails SET is_primary = true WHERE id IN (SELECT DISTINCT ON (user_id) id FROM emails WHERE deleted_at IS NULL ORDER BY user_id, created_at, id) AND NOT EXISTS (SELECT 1 FROM emails primaries WHERE primaries.user_id = emails.user_id AND primaries.is_primary AND primaries.deleted_at IS NULL)",
+						"CREATE UNIQUE INDEX IF NOT EXISTS idx_emails_user_id_primary ON emails (user_id) WHERE is_primary AND deleted_at IS NULL",
+					}
+				case "mysql":
+					statements = []string{
+						"UPDATE emails JOIN (SELECT user_id, MIN(id) AS id FROM emails WHERE deleted_at IS NULL GROUP BY user_id HAVING SUM(is_primary) = 0) firsts ON firsts.id = emails.id SET emails.is_primary = true",
+					}
+
+					if !tx.Migrator().HasColumn("emails", "primary_user_id") {
+						statements = append(statements, "ALTER TABLE emails ADD COLUMN primary_user_id varchar(45) GENERATED ALWAYS AS (IF(is_primary AND deleted_at IS NULL, user_id, NULL)) STORED")
+					}
+
+					if !tx.Migrator().HasIndex("emails", "idx_emails_user_id_primary") {
+						statements = append(statements, "CREATE UNIQUE INDEX idx_emails_user_id_primary ON emails (primary_user_id)")
+					}
+				}
+
+				for _, statement := range statements {
+					if err := tx.Exec(statement).Error; err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			Down: func(tx *gorm.DB) error {
+				switch tx.Dialector.Name() {
+				case "postgres":
+					if err := tx.Exec("DROP INDEX IF EXISTS idx_emails_user_id_primary").Error; err != nil {
+						return err
+					}
+				case "mysql":
+					if tx.Migrator().HasIndex("emails", "idx_emails_user_id_primary") {
+						if err := tx.Migrator().DropIndex("emails", "idx_emails_user_id_primary"); err != nil {
+							return err
+						}
+					}
+
+					if tx.Migrator().HasColumn("emails", "primary_user_id") {
+						if err := tx.Migrator().DropColumn("emails", "primary_user_id"); err != nil {
+							return err
+						}
+					}
+				}
+
+				return tx.Table("emails").Migrator().DropColumn("emails", "is_primary")
+			},
+		},
+		{
+			Version: 20261017000005,
+			Name:    "add_unique_email_index",
+			Up: func(tx *gorm.DB) error {
+				var statements []string
+
+				switch tx.Dialector.Name() {
+				case "postgres":
+					statements = []string{
+						"CREATE UNIQUE INDEX IF NOT EXISTS idx_emails_email_unique ON emails (lower(email)) WHERE deleted_at IS NULL",
+					}
+				case "mysql":
+					if !tx.Migrator().HasColumn("emails", "active_email") {
+						statements = append(statements, "ALTER TABLE emails ADD COLUMN active_email varchar(255) GENERATED ALWAYS AS (IF(deleted_at IS NULL, LOWER(email), NULL)) STORED")
+					}
+
+					if !tx.Migrator().HasIndex("emails", "idx_emails_email_unique") {
+						statements = append(statements, "CREATE UNIQUE INDEX idx_emails_email_unique ON emails (active_email)")
+					}
+				}
+
+				for _, statement := range statements {
+					if err := tx.Exec(statement).Error; err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			Down: func(tx *gorm.DB) error {
+				switch tx.Dialector.Name() {
+				case "postgres":
+					return tx.Exec("DROP INDEX IF EXISTS idx_emails_email_unique").Error
+				case "mysql":
+					if tx.Migrator().HasIndex("emails", "idx_emails_email_unique") {
+						if err := tx.Migrator().DropIndex("emails", "idx_emails_email_unique"); err != nil {
+							return err
+						}
+					}
+
+					if tx.Migrator().HasColumn("emails", "active_email") {
+						return tx.Migrator().DropColumn("emails", "active_email")
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			Version: 20261017000006,
+			Name:    "add_verified_at_to_emails_table",
+			Up: func(tx *gorm.DB) error {
+				type Email struct {
+					VerifiedAt *time.Time `gorm:"Column:verified_at;type:timestamptz"`
+				}
+
+				if tx.Migrator().HasColumn(&Email{}, "verified_at") {
+					return nil
+				}
+
+				return tx.Table("emails").Migrator().AddColumn(&Email{}, "VerifiedAt")
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Table("emails").Migrator().DropColumn("emails", "verified_at")
+			},
+		},
+		{
+			Version: 20261017000007,
+			Name:    "add_version_to_users_table",
+			Up: func(tx *gorm.DB) error {
+				type User struct {
+					Version int64 `gorm:"Column:version;not null;default:1"`
+				}
+
+				if tx.Migrator().HasColumn(&User{}, "version") {
+					return nil
+				}
+
+				return tx.Table("users").Migrator().AddColumn(&User{}, "Version")
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Table("users").Migrator().DropColumn("users", "version")
+			},
+		},
+	}
+}
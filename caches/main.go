@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/MrAndreID/goapi/retries"
+
 	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
@@ -15,6 +17,7 @@ type Cache struct {
 	Port       string
 	Username   string
 	Password   string
+	Retry      retries.Policy
 }
 
 type CacheConnection struct {
@@ -28,14 +31,20 @@ func New(cache *Cache) (*CacheConnection, error) {
 		err       error
 	)
 
-	switch cache.Connection {
-	case "redis":
-		cacheData, err = cache.Redis()
-	case "memcached":
-		cacheData, err = cache.Memcached()
-	default:
-		err = errors.New("Cache Connection Not Found")
-	}
+	err = retries.Do(context.Background(), cache.Retry, "cache", func() error {
+		var err error
+
+		switch cache.Connection {
+		case "redis":
+			cacheData, err = cache.Redis()
+		case "memcached":
+			cacheData, err = cache.Memcached()
+		default:
+			err = retries.Permanent(errors.New("Cache Connection Not Found"))
+		}
+
+		return err
+	})
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -57,9 +66,11 @@ func (cache *Cache) Redis() (*CacheConnection, error) {
 		DB:       0,
 	})
 
-	_, err := redisClient.Ping(context.Background()).Result()
+	cacheConnection := &CacheConnection{
+		Redis: redisClient,
+	}
 
-	if err != nil {
+	if err := cacheConnection.Ping(context.Background()); err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   "Caches.Main.Redis.01",
 			"error": err.Error(),
@@ -68,50 +79,68 @@ func (cache *Cache) Redis() (*CacheConnection, error) {
 		return nil, err
 	}
 
-	return &CacheConnection{
-		Redis: redisClient,
-	}, nil
+	return cacheConnection, nil
 }
 
 func (cache *Cache) Memcached() (*CacheConnection, error) {
-	var (
-		tag string = "Caches.Main.Memcached."
-		key string = "ping"
-	)
-
-	mc := memcache.New(cache.Host + ":" + cache.Port)
+	cacheConnection := &CacheConnection{
+		Memcached: memcache.New(cache.Host + ":" + cache.Port),
+	}
 
-	err := mc.Set(&memcache.Item{Key: key, Value: []byte("pong")})
-	if err != nil {
+	if err := cacheConnection.Ping(context.Background()); err != nil {
 		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "01",
+			"tag":   "Caches.Main.Memcached.01",
 			"error": err.Error(),
 		}).Error("failed to connect memcached")
 
 		return nil, err
 	}
 
-	_, err = mc.Get(key)
-	if err != nil {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "02",
-			"error": err.Error(),
-		}).Error("failed to connect memcached")
+	return cacheConnection, nil
+}
 
-		return nil, err
+func (cacheConnection *CacheConnection) Ping(ctx context.Context) error {
+	switch {
+	case cacheConnection.Redis != nil:
+		return cacheConnection.Redis.Ping(ctx).Err()
+	case cacheConnection.Memcached != nil:
+		result := make(chan error, 1)
+
+		go func() {
+			result <- cacheConnection.Memcached.Ping()
+		}()
+
+		select {
+		case err := <-result:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	default:
+		return errors.New("Cache Connection Not Found")
+	}
+}
+
+func (cacheConnection *CacheConnection) Close() error {
+	var err error
+
+	switch {
+	case cacheConnection.Redis != nil:
+		err = cacheConnection.Redis.Close()
+	case cacheConnection.Memcached != nil:
+		err = cacheConnection.Memcached.Close()
+	default:
+		err = errors.New("Cache Connection Not Found")
 	}
 
-	err = mc.Delete(key)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "03",
+			"tag":   "Caches.Main.Close.01",
 			"error": err.Error(),
-		}).Error("failed to connect memcached")
+		}).Error("failed to close connection (cache)")
 
-		return nil, err
+		return err
 	}
 
-	return &CacheConnection{
-		Memcached: mc,
-	}, nil
+	return nil
 }
@@ -1,56 +1,93 @@
 package configs
 
 import (
+	"time"
+
+	"github.com/MrAndreID/goapi/emails"
+	"github.com/MrAndreID/goapi/retries"
+
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
 )
 
 type Config struct {
-	AppName     string `env:"APP_NAME" envDefault:"Go Application Programming Interface (API)"`
-	AppPort     string `env:"APP_PORT,notEmpty"`
-	AppLocation string `env:"APP_LOCATION" envDefault:"Asia/Jakarta"`
-	AppDebug    bool   `env:"APP_DEBUG" envDefault:"false"`
-	AppVersion  string `env:"APP_VERSION" envDefault:"v1.0.0"`
-	AppKey      string `env:"APP_KEY"`
+	AppName            string        `env:"APP_NAME" envDefault:"Go Application Programming Interface (API)"`
+	AppPort            string        `env:"APP_PORT,notEmpty"`
+	AppLocation        string        `env:"APP_LOCATION" envDefault:"Asia/Jakarta"`
+	AppDebug           bool          `env:"APP_DEBUG" envDefault:"false"`
+	AppVersion         string        `env:"APP_VERSION" envDefault:"v1.0.0"`
+	AppKey             string        `env:"APP_KEY"`
+	AppShutdownTimeout time.Duration `env:"APP_SHUTDOWN_TIMEOUT" envDefault:"10s"`
 
 	UseBodyDumpLog bool `env:"USE_BODY_DUMP_LOG" envDefault:"false"`
 
-	UseDatabase        bool   `env:"USE_DATABASE" envDefault:"false"`
-	DatabaseConnection string `env:"DATABASE_CONNECTION"`
-	DatabaseHost       string `env:"DATABASE_HOST"`
-	DatabasePort       string `env:"DATABASE_PORT"`
-	DatabaseUsername   string `env:"DATABASE_USERNAME"`
-	DatabasePassword   string `env:"DATABASE_PASSWORD"`
-	DatabaseName       string `env:"DATABASE_NAME"`
-	DatabaseSSLMode    string `env:"DATABASE_SSL_MODE" envDefault:"disable"`
-	DatabaseParseTime  string `env:"DATABASE_PARSE_TIME" envDefault:"True"`
-	DatabaseCharset    string `env:"DATABASE_CHARSET" envDefault:"utf8mb4"`
-	DatabaseTimezone   string `env:"DATABASE_TIMEZONE" envDefault:"Asia/Jakarta"`
-
-	UseCache        bool   `env:"USE_CACHE" envDefault:"false"`
-	CacheConnection string `env:"CACHE_CONNECTION"`
-	CacheHost       string `env:"CACHE_HOST"`
-	CachePort       string `env:"CACHE_PORT"`
-	CacheUsername   string `env:"CACHE_USERNAME"`
-	CachePassword   string `env:"CACHE_PASSWORD"`
-
-	UseObjectStorage        bool   `env:"USE_OBJECT_STORAGE" envDefault:"false"`
-	ObjectStorageConnection string `env:"OBJECT_STORAGE_CONNECTION"`
-	ObjectStorageHost       string `env:"OBJECT_STORAGE_HOST"`
-	ObjectStoragePort       string `env:"OBJECT_STORAGE_PORT"`
-	ObjectStorageUsername   string `env:"OBJECT_STORAGE_USERNAME"`
-	ObjectStoragePassword   string `env:"OBJECT_STORAGE_PASSWORD"`
-	ObjectStorageSSL        bool   `env:"OBJECT_STORAGE_SSL"`
-
-	UseMessageBroker        bool   `env:"USE_MESSAGE_BROKER" envDefault:"false"`
-	MessageBrokerConnection string `env:"MESSAGE_BROKER_CONNECTION"`
-	MessageBrokerHost       string `env:"MESSAGE_BROKER_HOST"`
-	MessageBrokerPort       string `env:"MESSAGE_BROKER_PORT"`
-	MessageBrokerUsername   string `env:"MESSAGE_BROKER_USERNAME"`
-	MessageBrokerPassword   string `env:"MESSAGE_BROKER_PASSWORD"`
-	MessageBrokerName       string `env:"MESSAGE_BROKER_NAME"`
-	MessageBrokerPartition  int    `env:"MESSAGE_BROKER_PARTITION"`
+	HealthCheckTimeout time.Duration `env:"HEALTH_CHECK_TIMEOUT" envDefault:"2s"`
+
+	BulkMaxItems int `env:"BULK_MAX_ITEMS" envDefault:"100"`
+
+	StrictConcurrency bool `env:"STRICT_CONCURRENCY" envDefault:"false"`
+
+	HTTPCacheControl       string            `env:"HTTP_CACHE_CONTROL" envDefault:"private, no-cache"`
+	HTTPCacheControlRoutes map[string]string `env:"HTTP_CACHE_CONTROL_ROUTES" envSeparator:";" envKeyValSeparator:"="`
+
+	EmailPolicy          emails.Config `envPrefix:"EMAIL_"`
+	EmailVerificationTTL time.Duration `env:"EMAIL_VERIFICATION_TTL" envDefault:"24h"`
+
+	NotifierConnection   string        `env:"NOTIFIER_CONNECTION" envDefault:"log"`
+	NotifierHost         string        `env:"NOTIFIER_HOST"`
+	NotifierPort         string        `env:"NOTIFIER_PORT"`
+	NotifierUsername     string        `env:"NOTIFIER_USERNAME"`
+	NotifierPassword     string        `env:"NOTIFIER_PASSWORD"`
+	NotifierFrom         string        `env:"NOTIFIER_FROM"`
+	NotifierTimeout      time.Duration `env:"NOTIFIER_TIMEOUT" envDefault:"10s"`
+	NotifierQueueSize    int           `env:"NOTIFIER_QUEUE_SIZE" envDefault:"100"`
+	NotifierQueueWorkers int           `env:"NOTIFIER_QUEUE_WORKERS" envDefault:"2"`
+
+	UseDatabase            bool           `env:"USE_DATABASE" envDefault:"false"`
+	DatabaseConnection     string         `env:"DATABASE_CONNECTION"`
+	DatabaseHost           string         `env:"DATABASE_HOST"`
+	DatabasePort           string         `env:"DATABASE_PORT"`
+	DatabaseUsername       string         `env:"DATABASE_USERNAME"`
+	DatabasePassword       string         `env:"DATABASE_PASSWORD"`
+	DatabaseName           string         `env:"DATABASE_NAME"`
+	DatabaseSSLMode        string         `env:"DATABASE_SSL_MODE" envDefault:"disable"`
+	DatabaseParseTime      string         `env:"DATABASE_PARSE_TIME" envDefault:"True"`
+	DatabaseCharset        string         `env:"DATABASE_CHARSET" envDefault:"utf8mb4"`
+	DatabaseTimezone       string         `env:"DATABASE_TIMEZONE" envDefault:"Asia/Jakarta"`
+	DatabaseOptional       bool           `env:"DATABASE_OPTIONAL" envDefault:"false"`
+	DatabaseFullTextSearch bool           `env:"DATABASE_FULL_TEXT_SEARCH" envDefault:"false"`
+	DatabaseRetry          retries.Policy `envPrefix:"DATABASE_RETRY_"`
+
+	UseCache        bool           `env:"USE_CACHE" envDefault:"false"`
+	CacheConnection string         `env:"CACHE_CONNECTION"`
+	CacheHost       string         `env:"CACHE_HOST"`
+	CachePort       string         `env:"CACHE_PORT"`
+	CacheUsername   string         `env:"CACHE_USERNAME"`
+	CachePassword   string         `env:"CACHE_PASSWORD"`
+	CacheOptional   bool           `env:"CACHE_OPTIONAL" envDefault:"false"`
+	CacheRetry      retries.Policy `envPrefix:"CACHE_RETRY_"`
+
+	UseObjectStorage        bool           `env:"USE_OBJECT_STORAGE" envDefault:"false"`
+	ObjectStorageConnection string         `env:"OBJECT_STORAGE_CONNECTION"`
+	ObjectStorageHost       string         `env:"OBJECT_STORAGE_HOST"`
+	ObjectStoragePort       string         `env:"OBJECT_STORAGE_PORT"`
+	ObjectStorageUsername   string         `env:"OBJECT_STORAGE_USERNAME"`
+	ObjectStoragePassword   string         `env:"OBJECT_STORAGE_PASSWORD"`
+	ObjectStorageSSL        bool           `env:"OBJECT_STORAGE_SSL"`
+	ObjectStorageOptional   bool           `env:"OBJECT_STORAGE_OPTIONAL" envDefault:"false"`
+	ObjectStorageRetry      retries.Policy `envPrefix:"OBJECT_STORAGE_RETRY_"`
+
+	UseMessageBroker        bool           `env:"USE_MESSAGE_BROKER" envDefault:"false"`
+	MessageBrokerConnection string         `env:"MESSAGE_BROKER_CONNECTION"`
+	MessageBrokerHost       string         `env:"MESSAGE_BROKER_HOST"`
+	MessageBrokerPort       string         `env:"MESSAGE_BROKER_PORT"`
+	MessageBrokerUsername   string         `env:"MESSAGE_BROKER_USERNAME"`
+	MessageBrokerPassword   string         `env:"MESSAGE_BROKER_PASSWORD"`
+	MessageBrokerName       string         `env:"MESSAGE_BROKER_NAME"`
+	MessageBrokerPartition  int            `env:"MESSAGE_BROKER_PARTITION"`
+	MessageBrokerOptional   bool           `env:"MESSAGE_BROKER_OPTIONAL" envDefault:"false"`
+	MessageBrokerRetry      retries.Policy `envPrefix:"MESSAGE_BROKER_RETRY_"`
 
 	AllowedOrigins []string `env:"ALLOWED_ORIGINS" envSeparator:","`
 }
@@ -0,0 +1,229 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+var extensions []string = []string{".yaml", ".yml", ".json"}
+
+func Load(db *gorm.DB, fsys fs.FS, models ...any) error {
+	var tag string = "Databases.Fixtures.Main.Load."
+
+	sorted, err := Sort(db, models...)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to sort models by dependency")
+
+		return err
+	}
+
+	for _, model := range sorted {
+		stmt := &gorm.Statement{DB: db}
+
+		if err := stmt.Parse(model); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": err.Error(),
+			}).Error("failed to parse model")
+
+			return err
+		}
+
+		records, keys, found, err := read(fsys, stmt.Schema.Table, model)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"table": stmt.Schema.Table,
+				"error": err.Error(),
+			}).Error("failed to read fixture")
+
+			return err
+		}
+
+		if !found || reflect.ValueOf(records).Elem().Len() == 0 {
+			continue
+		}
+
+		onConflict := clause.OnConflict{DoNothing: true}
+
+		if columns := declaredColumns(stmt.Schema, keys); len(columns) > 0 {
+			onConflict = clause.OnConflict{DoUpdates: clause.AssignmentColumns(columns)}
+
+			for _, field := range stmt.Schema.PrimaryFields {
+				onConflict.Columns = append(onConflict.Columns, clause.Column{Name: field.DBName})
+			}
+		}
+
+		result := db.Clauses(onConflict).Create(records)
+
+		if result.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"table": stmt.Schema.Table,
+				"error": result.Error.Error(),
+			}).Error("failed to upsert fixture")
+
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
+func Sort(db *gorm.DB, models ...any) ([]any, error) {
+	var (
+		tables       = make([]string, len(models))
+		byTable      = make(map[string]any, len(models))
+		dependencies = make(map[string]map[string]bool, len(models))
+		sorted       = make([]any, 0, len(models))
+	)
+
+	for i, model := range models {
+		stmt := &gorm.Statement{DB: db}
+
+		if err := stmt.Parse(model); err != nil {
+			return nil, err
+		}
+
+		tables[i] = stmt.Schema.Table
+		byTable[stmt.Schema.Table] = model
+
+		if dependencies[stmt.Schema.Table] == nil {
+			dependencies[stmt.Schema.Table] = map[string]bool{}
+		}
+
+		for _, relationship := range stmt.Schema.Relationships.Relations {
+			switch relationship.Type {
+			case schema.HasOne, schema.HasMany:
+				if dependencies[relationship.FieldSchema.Table] == nil {
+					dependencies[relationship.FieldSchema.Table] = map[string]bool{}
+				}
+
+				dependencies[relationship.FieldSchema.Table][stmt.Schema.Table] = true
+			case schema.BelongsTo:
+				dependencies[stmt.Schema.Table][relationship.FieldSchema.Table] = true
+			}
+		}
+	}
+
+	done := make(map[string]bool, len(models))
+
+	for len(sorted) < len(models) {
+		progressed := false
+
+		for _, table := range tables {
+			if done[table] {
+				continue
+			}
+
+			ready := true
+
+			for dependency := range dependencies[table] {
+				if _, ok := byTable[dependency]; ok && !done[dependency] && dependency != table {
+					ready = false
+
+					break
+				}
+			}
+
+			if ready {
+				done[table] = true
+				sorted = append(sorted, byTable[table])
+				progressed = true
+			}
+		}
+
+		if !progressed {
+			return nil, errors.New("Circular Model Dependency")
+		}
+	}
+
+	return sorted, nil
+}
+
+func declaredColumns(modelSchema *schema.Schema, keys map[string]bool) []string {
+	var columns []string
+
+	for _, field := range modelSchema.Fields {
+		if field.DBName == "" || field.PrimaryKey {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if keys[name] {
+			columns = append(columns, field.DBName)
+		}
+	}
+
+	return columns
+}
+
+func read(fsys fs.FS, table string, model any) (any, map[string]bool, bool, error) {
+	for _, extension := range extensions {
+		content, err := fs.ReadFile(fsys, table+extension)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		var rows []map[string]any
+
+		if path.Ext(table+extension) == ".json" {
+			err = json.Unmarshal(content, &rows)
+		} else {
+			err = yaml.Unmarshal(content, &rows)
+		}
+
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		rowsJSON, err := json.Marshal(rows)
+
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		records := reflect.New(reflect.SliceOf(reflect.TypeOf(model).Elem()))
+
+		if err := json.Unmarshal(rowsJSON, records.Interface()); err != nil {
+			return nil, nil, false, err
+		}
+
+		keys := map[string]bool{}
+
+		for _, row := range rows {
+			for key := range row {
+				keys[key] = true
+			}
+		}
+
+		return records.Interface(), keys, true, nil
+	}
+
+	return nil, nil, false, nil
+}
@@ -1,9 +1,12 @@
 package databases
 
 import (
+	"context"
 	"errors"
 	"strings"
 
+	"github.com/MrAndreID/goapi/retries"
+
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
@@ -21,6 +24,7 @@ type Database struct {
 	ParseTime  string
 	Charset    string
 	Timezone   string
+	Retry      retries.Policy
 }
 
 func New(database *Database, debug bool) (*gorm.DB, error) {
@@ -29,14 +33,20 @@ func New(database *Database, debug bool) (*gorm.DB, error) {
 		err error
 	)
 
-	switch database.Connection {
-	case "postgresql":
-		db, err = database.PostgreSQL()
-	case "mysql":
-		db, err = database.MySQL()
-	default:
-		err = errors.New("Database Connection Not Found")
-	}
+	err = retries.Do(context.Background(), database.Retry, "database", func() error {
+		var err error
+
+		switch database.Connection {
+		case "postgresql":
+			db, err = database.PostgreSQL()
+		case "mysql":
+			db, err = database.MySQL()
+		default:
+			err = retries.Permanent(errors.New("Database Connection Not Found"))
+		}
+
+		return err
+	})
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -89,3 +99,37 @@ func (database *Database) MySQL() (*gorm.DB, error) {
 
 	return db, nil
 }
+
+func Ping(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.PingContext(ctx)
+}
+
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Databases.Main.Close.01",
+			"error": err.Error(),
+		}).Error("failed to get sql database")
+
+		return err
+	}
+
+	if err := sqlDB.Close(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Databases.Main.Close.02",
+			"error": err.Error(),
+		}).Error("failed to close connection (database)")
+
+		return err
+	}
+
+	return nil
+}
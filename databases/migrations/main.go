@@ -1,23 +1,24 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/MrAndreID/goapi/applications"
 	"github.com/MrAndreID/goapi/configs"
 	"github.com/MrAndreID/goapi/databases"
-	"github.com/MrAndreID/goapi/databases/models"
+	"github.com/MrAndreID/goapi/databases/migrator"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cast"
 	"gorm.io/gorm"
 )
 
-var tables map[string]interface{} = map[string]interface{}{
-	"users":  &models.User{},
-	"emails": &models.Email{},
-}
-
 func main() {
 	var tag string = "Databases.Migrations.Main.Main."
 
@@ -53,6 +54,7 @@ func main() {
 			ParseTime:  cfg.DatabaseParseTime,
 			Charset:    cfg.DatabaseCharset,
 			Timezone:   cfg.DatabaseTimezone,
+			Retry:      cfg.DatabaseRetry,
 		}, cfg.AppDebug)
 
 		if err != nil {
@@ -107,12 +109,44 @@ func main() {
 
 	fmt.Println("Start Migration")
 
-	err = Migrate(dbConnection)
+	migrateMode := cast.ToString(migrateFlag)
+
+	m, err := New(dbConnection)
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "06",
 			"error": err.Error(),
+		}).Error("failed to initiate migrator")
+
+		return
+	}
+
+	switch {
+	case migrateMode == "default", migrateMode == "fresh", migrateMode == "up":
+		err = m.Up()
+	case migrateMode == "down":
+		err = m.Down()
+	case migrateMode == "redo":
+		err = m.Redo()
+	case migrateMode == "status":
+		err = Status(m)
+	case strings.HasPrefix(migrateMode, "to="):
+		var version int64
+
+		version, err = strconv.ParseInt(strings.TrimPrefix(migrateMode, "to="), 10, 64)
+
+		if err == nil {
+			err = m.To(version)
+		}
+	default:
+		err = errors.New("Migrate Mode Not Found")
+	}
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "07",
+			"error": err.Error(),
 		}).Error("failed to migrate")
 
 		return
@@ -121,23 +155,41 @@ func main() {
 	fmt.Println("End Migration")
 }
 
-func Migrate(db *gorm.DB) error {
-	for i, v := range tables {
-		fmt.Println("Migrating: " + i + " Table")
+func New(db *gorm.DB) (*migrator.Migrator, error) {
+	var migrations []migrator.Migration
+
+	for _, module := range applications.Modules() {
+		migrations = append(migrations, module.Migrations()...)
+	}
+
+	return migrator.New(db, migrations)
+}
+
+func Status(m *migrator.Migrator) error {
+	statuses, err := m.Status()
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Databases.Migrations.Main.Status.01",
+			"error": err.Error(),
+		}).Error("failed to get migration status")
+
+		return err
+	}
 
-		err := db.Migrator().AutoMigrate(v)
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
-		if err != nil {
-			logrus.WithFields(logrus.Fields{
-				"tag":   "Databases.Migrations.Main.Migrate.01",
-				"error": err.Error(),
-			}).Error("failed to create table")
+	fmt.Fprintln(writer, "Version\tName\tApplied At")
+
+	for _, status := range statuses {
+		appliedAt := "Pending"
 
-			return err
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
 		}
 
-		fmt.Println("Migrated: " + i + " Table")
+		fmt.Fprintf(writer, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
 	}
 
-	return nil
+	return writer.Flush()
 }
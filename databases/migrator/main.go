@@ -0,0 +1,318 @@
+package migrator
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(*gorm.DB) error
+	Down    func(*gorm.DB) error
+}
+
+type SchemaMigration struct {
+	Version   int64     `gorm:"primaryKey;Column:version;autoIncrement:false"`
+	Name      string    `gorm:"Column:name;type:varchar(255);not null"`
+	AppliedAt time.Time `gorm:"Column:applied_at;not null"`
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	Database   *gorm.DB
+	Migrations []Migration
+}
+
+func New(db *gorm.DB, migrations []Migration) (*Migrator, error) {
+	var tag string = "Databases.Migrator.Main.New."
+
+	sorted := make([]Migration, len(migrations))
+
+	copy(sorted, migrations)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Version == sorted[i-1].Version {
+			err := fmt.Errorf("Duplicate Migration Version %d", sorted[i].Version)
+
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("duplicate migration version")
+
+			return nil, err
+		}
+	}
+
+	if err := db.Migrator().AutoMigrate(&SchemaMigration{}); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to create schema migrations table")
+
+		return nil, err
+	}
+
+	return &Migrator{
+		Database:   db,
+		Migrations: sorted,
+	}, nil
+}
+
+func (m *Migrator) Up() error {
+	applied, err := m.applied()
+
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range m.Migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		if err := m.up(migration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) Down() error {
+	migration, err := m.last()
+
+	if err != nil || migration == nil {
+		return err
+	}
+
+	return m.down(*migration)
+}
+
+func (m *Migrator) Redo() error {
+	migration, err := m.last()
+
+	if err != nil || migration == nil {
+		return err
+	}
+
+	if err := m.down(*migration); err != nil {
+		return err
+	}
+
+	return m.up(*migration)
+}
+
+func (m *Migrator) To(version int64) error {
+	var tag string = "Databases.Migrator.Main.To."
+
+	if version != 0 && m.find(version) == nil {
+		err := fmt.Errorf("Migration Version %d Not Found", version)
+
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("migration version not found")
+
+		return err
+	}
+
+	applied, err := m.applied()
+
+	if err != nil {
+		return err
+	}
+
+	for i := len(m.Migrations) - 1; i >= 0; i-- {
+		if _, ok := applied[m.Migrations[i].Version]; ok && m.Migrations[i].Version > version {
+			if err := m.down(m.Migrations[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, migration := range m.Migrations {
+		if _, ok := applied[migration.Version]; !ok && migration.Version <= version {
+			if err := m.up(migration); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) Status() ([]Status, error) {
+	applied, err := m.applied()
+
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.Migrations))
+
+	for _, migration := range m.Migrations {
+		status := Status{
+			Version: migration.Version,
+			Name:    migration.Name,
+		}
+
+		if record, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &record.AppliedAt
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) up(migration Migration) error {
+	var tag string = "Databases.Migrator.Main.Up."
+
+	fmt.Printf("Migrating: %d_%s\n", migration.Version, migration.Name)
+
+	err := m.transaction(func(tx *gorm.DB) error {
+		if migration.Up != nil {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(&SchemaMigration{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":     tag + "01",
+			"version": migration.Version,
+			"error":   err.Error(),
+		}).Error("failed to migrate up")
+
+		return err
+	}
+
+	fmt.Printf("Migrated: %d_%s\n", migration.Version, migration.Name)
+
+	return nil
+}
+
+func (m *Migrator) down(migration Migration) error {
+	var tag string = "Databases.Migrator.Main.Down."
+
+	fmt.Printf("Rolling Back: %d_%s\n", migration.Version, migration.Name)
+
+	err := m.transaction(func(tx *gorm.DB) error {
+		if migration.Down == nil {
+			return fmt.Errorf("Migration Version %d Is Irreversible", migration.Version)
+		}
+
+		if err := migration.Down(tx); err != nil {
+			return err
+		}
+
+		return tx.Delete(&SchemaMigration{}, "version = ?", migration.Version).Error
+	})
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":     tag + "01",
+			"version": migration.Version,
+			"error":   err.Error(),
+		}).Error("failed to migrate down")
+
+		return err
+	}
+
+	fmt.Printf("Rolled Back: %d_%s\n", migration.Version, migration.Name)
+
+	return nil
+}
+
+// MySQL commits implicitly on every DDL statement, so only PostgreSQL wraps a migration in a transaction.
+func (m *Migrator) transaction(fn func(*gorm.DB) error) error {
+	if m.Database.Dialector.Name() == "postgres" {
+		return m.Database.Transaction(fn)
+	}
+
+	return fn(m.Database)
+}
+
+func (m *Migrator) applied() (map[int64]SchemaMigration, error) {
+	var records []SchemaMigration
+
+	if err := m.Database.Order("version").Find(&records).Error; err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Databases.Migrator.Main.Applied.01",
+			"error": err.Error(),
+		}).Error("failed to read schema migrations")
+
+		return nil, err
+	}
+
+	applied := make(map[int64]SchemaMigration, len(records))
+
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+func (m *Migrator) last() (*Migration, error) {
+	var record SchemaMigration
+
+	result := m.Database.Order("version desc").Limit(1).Find(&record)
+
+	if result.Error != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Databases.Migrator.Main.Last.01",
+			"error": result.Error.Error(),
+		}).Error("failed to read schema migrations")
+
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	migration := m.find(record.Version)
+
+	if migration == nil {
+		return nil, fmt.Errorf("Migration Version %d Not Found", record.Version)
+	}
+
+	return migration, nil
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for i := range m.Migrations {
+		if m.Migrations[i].Version == version {
+			return &m.Migrations[i]
+		}
+	}
+
+	return nil
+}
@@ -7,12 +7,14 @@ import (
 )
 
 type Email struct {
-	ID        string         `gorm:"primaryKey;Column:id;type:varchar(45)" json:"id"`
-	CreatedAt time.Time      `gorm:"Column:created_at;type:timestamptz;not null" json:"createdAt"`
-	UpdatedAt time.Time      `gorm:"Column:updated_at;type:timestamptz;not null" json:"updatedAt"`
-	DeletedAt gorm.DeletedAt `gorm:"Column:deleted_at;type:timestamptz" json:"deletedAt"`
-	UserID    string         `gorm:"Column:user_id;type:varchar(45);not null" json:"userId"`
-	Email     string         `gorm:"Column:email;type:varchar(255);not null" json:"email"`
+	ID         string         `gorm:"primaryKey;Column:id;type:varchar(45)" json:"id"`
+	CreatedAt  time.Time      `gorm:"Column:created_at;type:timestamptz;not null" json:"createdAt"`
+	UpdatedAt  time.Time      `gorm:"Column:updated_at;type:timestamptz;not null" json:"updatedAt"`
+	DeletedAt  gorm.DeletedAt `gorm:"Column:deleted_at;type:timestamptz" json:"deletedAt"`
+	UserID     string         `gorm:"Column:user_id;type:varchar(45);not null" json:"userId"`
+	Email      string         `gorm:"Column:email;type:varchar(255);not null" json:"email"`
+	Primary    bool           `gorm:"Column:is_primary;not null;default:false" json:"primary"`
+	VerifiedAt *time.Time     `gorm:"Column:verified_at;type:timestamptz" json:"verifiedAt"`
 }
 
 func (Email) TableName() string {
@@ -12,6 +12,7 @@ type User struct {
 	UpdatedAt time.Time      `gorm:"Column:updated_at;type:timestamptz;not null" json:"updatedAt"`
 	DeletedAt gorm.DeletedAt `gorm:"Column:deleted_at;type:timestamptz" json:"deletedAt"`
 	Name      string         `gorm:"Column:name;type:varchar(255);not null" json:"name"`
+	Version   int64          `gorm:"Column:version;not null;default:1" json:"version"`
 	Emails    []Email        `gorm:"foreignKey:UserID;references:ID" json:"emails"`
 }
 
@@ -1,69 +1,25 @@
 package main
 
 import (
+	"embed"
 	"flag"
 	"fmt"
+	"io/fs"
+	"math/rand/v2"
+	"path"
 	"time"
 
+	"github.com/MrAndreID/goapi/applications"
 	"github.com/MrAndreID/goapi/configs"
 	"github.com/MrAndreID/goapi/databases"
-	"github.com/MrAndreID/goapi/databases/models"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cast"
 	"gorm.io/gorm"
 )
 
-var seeder map[string]map[string]interface{} = map[string]map[string]interface{}{
-	"user1": {
-		"model": &models.User{},
-		"data": &models.User{
-			ID:        "09123ae8-cce2-4d40-aac1-ae1b3c51cc77",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-			Name:      "Andrea Adam",
-		},
-	},
-	"user2": {
-		"model": &models.User{},
-		"data": &models.User{
-			ID:        "7f5abfff-fae9-4c0d-8433-50f650583dac",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-			Name:      "Zelda Skyward",
-		},
-	},
-	"email1": {
-		"model": &models.Email{},
-		"data": &models.Email{
-			ID:        "092fa1d6-aea8-4a0d-86d1-1c242d0f8ce5",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-			UserID:    "09123ae8-cce2-4d40-aac1-ae1b3c51cc77",
-			Email:     "mrandreid.business@gmail.com",
-		},
-	},
-	"email2": {
-		"model": &models.Email{},
-		"data": &models.Email{
-			ID:        "902872a1-3c73-4fc5-8b9a-269203209d68",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-			UserID:    "09123ae8-cce2-4d40-aac1-ae1b3c51cc77",
-			Email:     "andrea.adam.306147@brilian.bri.co.id",
-		},
-	},
-	"email3": {
-		"model": &models.Email{},
-		"data": &models.Email{
-			ID:        "61e5efb6-5da0-470f-a3ee-1109a2ea590e",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-			UserID:    "7f5abfff-fae9-4c0d-8433-50f650583dac",
-			Email:     "zelda.skyward@email.com",
-		},
-	},
-}
+//go:embed fixtures
+var fixturesFS embed.FS
 
 func main() {
 	var tag string = "Databases.Seeders.Main.Main."
@@ -100,6 +56,7 @@ func main() {
 			ParseTime:  cfg.DatabaseParseTime,
 			Charset:    cfg.DatabaseCharset,
 			Timezone:   cfg.DatabaseTimezone,
+			Retry:      cfg.DatabaseRetry,
 		}, cfg.AppDebug)
 
 		if err != nil {
@@ -116,54 +73,97 @@ func main() {
 
 	seedFlag := flag.String("seed", "default", "For Seed")
 
+	countFlag := flag.Int("count", 1000, "For Count of Fake Data")
+
+	rngSeedFlag := flag.Uint64("rng-seed", 0, "For Random Number Generator Seed of Fake Data")
+
 	flag.Parse()
 
-	if cast.ToString(seedFlag) == "default" {
-		fmt.Println("Start Seed")
-
-		for i, v := range seeder {
-			fmt.Println("Seeding: " + i + " Data")
-
-			for key, data := range v {
-				if key == "model" {
-					if !dbConnection.Migrator().HasTable(data) {
-						logrus.WithFields(logrus.Fields{
-							"tag":   tag + "04",
-							"error": "Failed to Initiate Table",
-						}).Error("failed to initiate table")
-
-						return
-					}
-				}
-
-				if key == "data" {
-					result := dbConnection.Create(data)
-
-					if result.Error != nil {
-						logrus.WithFields(logrus.Fields{
-							"tag":   tag + "05",
-							"error": result.Error.Error(),
-						}).Error("failed to create data")
-
-						return
-					}
-
-					if result.RowsAffected == 0 {
-						logrus.WithFields(logrus.Fields{
-							"tag":   tag + "06",
-							"error": "Failed to Create Data",
-						}).Error("failed to create data")
-
-						return
-					}
-				}
+	seedName := cast.ToString(seedFlag)
+
+	if seedName == "fake" {
+		rngSeed := cast.ToUint64(rngSeedFlag)
+
+		if rngSeed == 0 {
+			rngSeed = uint64(time.Now().UnixNano())
+		}
+
+		fmt.Printf("Start Seed: fake (count=%d, rng-seed=%d)\n", cast.ToInt(countFlag), rngSeed)
+
+		rng := rand.New(rand.NewPCG(rngSeed, rngSeed))
+
+		for _, module := range applications.Modules() {
+			fakeSeeder, ok := module.(applications.FakeSeeder)
+
+			if !ok {
+				continue
 			}
 
-			fmt.Println("Seeded: " + i + " Data")
+			fmt.Println("Seeding: " + module.Name() + " Module")
+
+			if err := fakeSeeder.SeedFake(dbConnection, cast.ToInt(countFlag), rng); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "07",
+					"error": err.Error(),
+				}).Error("failed to seed fake data")
+
+				return
+			}
+
+			fmt.Println("Seeded: " + module.Name() + " Module")
 		}
 
 		fmt.Println("End Seed")
+
+		fmt.Println("End Seeder")
+
+		return
+	}
+
+	fixtureSet, err := fs.Sub(fixturesFS, path.Join("fixtures", seedName))
+
+	if err == nil {
+		_, err = fs.Stat(fixtureSet, ".")
+	}
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "06",
+			"error": err.Error(),
+		}).Error("failed to find fixture set")
+
+		return
 	}
 
+	fmt.Println("Start Seed: " + seedName)
+
+	for _, module := range applications.Modules() {
+		fmt.Println("Seeding: " + module.Name() + " Module")
+
+		for _, model := range module.Models() {
+			if !dbConnection.Migrator().HasTable(model) {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "04",
+					"error": "Failed to Initiate Table",
+				}).Error("failed to initiate table")
+
+				return
+			}
+		}
+
+		if err := module.Seed(dbConnection, fixtureSet); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "05",
+				"error": err.Error(),
+			}).Error("failed to seed module")
+
+			return
+		}
+
+		fmt.Println("Seeded: " + module.Name() + " Module")
+	}
+
+	fmt.Println("End Seed")
+
 	fmt.Println("End Seeder")
 }
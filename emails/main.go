@@ -0,0 +1,147 @@
+package emails
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+)
+
+var (
+	ErrDomainNotAllowed = errors.New("must use an allowed domain")
+	ErrDomainBlocked    = errors.New("must not use a blocked domain")
+	ErrDomainDisposable = errors.New("must not use a disposable domain")
+)
+
+type Config struct {
+	GmailNormalization    bool   `env:"GMAIL_NORMALIZATION" envDefault:"false"`
+	DomainAllowlistFile   string `env:"DOMAIN_ALLOWLIST_FILE"`
+	DomainBlocklistFile   string `env:"DOMAIN_BLOCKLIST_FILE"`
+	DisposableDomainsFile string `env:"DISPOSABLE_DOMAINS_FILE"`
+}
+
+type Policy struct {
+	GmailNormalization bool
+	Allowlist          map[string]struct{}
+	Blocklist          map[string]struct{}
+	Disposable         map[string]struct{}
+}
+
+func New(cfg Config) (*Policy, error) {
+	var (
+		policy *Policy = &Policy{GmailNormalization: cfg.GmailNormalization}
+		err    error
+	)
+
+	if policy.Allowlist, err = loadDomains(cfg.DomainAllowlistFile); err != nil {
+		return nil, err
+	}
+
+	if policy.Blocklist, err = loadDomains(cfg.DomainBlocklistFile); err != nil {
+		return nil, err
+	}
+
+	if policy.Disposable, err = loadDomains(cfg.DisposableDomainsFile); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (p *Policy) Normalize(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	if p == nil || !p.GmailNormalization {
+		return email
+	}
+
+	at := strings.LastIndex(email, "@")
+
+	if at == -1 {
+		return email
+	}
+
+	local, domain := email[:at], email[at+1:]
+
+	if domain != "gmail.com" && domain != "googlemail.com" {
+		return email
+	}
+
+	if plus := strings.Index(local, "+"); plus != -1 {
+		local = local[:plus]
+	}
+
+	return strings.ReplaceAll(local, ".", "") + "@gmail.com"
+}
+
+func (p *Policy) Validate(email string) error {
+	if p == nil {
+		return nil
+	}
+
+	domain := email[strings.LastIndex(email, "@")+1:]
+
+	if len(p.Allowlist) > 0 && !matchDomain(p.Allowlist, domain) {
+		return ErrDomainNotAllowed
+	}
+
+	if matchDomain(p.Blocklist, domain) {
+		return ErrDomainBlocked
+	}
+
+	if matchDomain(p.Disposable, domain) {
+		return ErrDomainDisposable
+	}
+
+	return nil
+}
+
+func matchDomain(domains map[string]struct{}, domain string) bool {
+	domain = strings.ToLower(domain)
+
+	for domain != "" {
+		if _, ok := domains[domain]; ok {
+			return true
+		}
+
+		dot := strings.Index(domain, ".")
+
+		if dot == -1 {
+			break
+		}
+
+		domain = domain[dot+1:]
+	}
+
+	return false
+}
+
+func loadDomains(path string) (map[string]struct{}, error) {
+	domains := map[string]struct{}{}
+
+	if path == "" {
+		return domains, nil
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+
+		line = strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(line)), "*."), "@")
+
+		if line != "" {
+			domains[line] = struct{}{}
+		}
+	}
+
+	return domains, scanner.Err()
+}
@@ -0,0 +1,83 @@
+package emails
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMissingKey   = errors.New("missing signing key")
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+type Token struct {
+	EmailID   string `json:"emailId"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func SignToken(key string, token Token) (string, error) {
+	if key == "" {
+		return "", ErrMissingKey
+	}
+
+	payload, err := json.Marshal(token)
+
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signature(key, encoded)), nil
+}
+
+func ParseToken(key string, value string, now time.Time) (Token, error) {
+	var token Token
+
+	if key == "" {
+		return token, ErrMissingKey
+	}
+
+	encoded, sig, ok := strings.Cut(value, ".")
+
+	if !ok {
+		return token, ErrInvalidToken
+	}
+
+	decodedSignature, err := base64.RawURLEncoding.DecodeString(sig)
+
+	if err != nil || !hmac.Equal(decodedSignature, signature(key, encoded)) {
+		return token, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return token, ErrInvalidToken
+	}
+
+	if err := json.Unmarshal(payload, &token); err != nil {
+		return token, ErrInvalidToken
+	}
+
+	if now.Unix() >= token.ExpiresAt {
+		return token, ErrExpiredToken
+	}
+
+	return token, nil
+}
+
+func signature(key string, payload string) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+
+	mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
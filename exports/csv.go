@@ -0,0 +1,30 @@
+package exports
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type CSVWriter struct {
+	writer *csv.Writer
+}
+
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{
+		writer: csv.NewWriter(w),
+	}
+}
+
+func (w *CSVWriter) Write(record []string) error {
+	return w.writer.Write(record)
+}
+
+func (w *CSVWriter) Flush() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+func (w *CSVWriter) Close() error {
+	return w.Flush()
+}
@@ -0,0 +1,41 @@
+package exports
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	FormatCSV  string = "csv"
+	FormatXLSX string = "xlsx"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+type Writer interface {
+	Write(record []string) error
+	Flush() error
+	Close() error
+}
+
+func New(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	case FormatXLSX:
+		return NewXLSXWriter(w)
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "application/octet-stream"
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const HeaderIfNoneMatch string = "If-None-Match"
+
+type CachePolicy struct {
+	CacheControl string
+	Routes       map[string]string
+}
+
+type cacheWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *cacheWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *cacheWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *cacheWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func CacheControl(policy CachePolicy) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			method := c.Request().Method
+
+			if method != http.MethodGet && method != http.MethodHead {
+				return next(c)
+			}
+
+			var (
+				response *echo.Response      = c.Response()
+				original http.ResponseWriter = response.Writer
+				writer   *cacheWriter        = &cacheWriter{header: original.Header(), status: http.StatusOK}
+			)
+
+			response.Writer = writer
+
+			err := next(c)
+
+			response.Writer = original
+
+			if !response.Committed {
+				return err
+			}
+
+			if writer.status != http.StatusOK {
+				return flushCacheWriter(original, writer, err)
+			}
+
+			header := original.Header()
+
+			if header.Get(HeaderETag) == "" {
+				sum := sha256.Sum256(writer.body.Bytes())
+
+				header.Set(HeaderETag, `W/"`+hex.EncodeToString(sum[:16])+`"`)
+			}
+
+			cacheControl, ok := policy.Routes[c.Path()]
+
+			if !ok {
+				cacheControl = policy.CacheControl
+			}
+
+			if cacheControl != "" {
+				header.Set(echo.HeaderCacheControl, cacheControl)
+				header.Del("Pragma")
+			}
+
+			if notModified(c.Request(), header) {
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+
+				response.Size = 0
+
+				original.WriteHeader(http.StatusNotModified)
+
+				return err
+			}
+
+			return flushCacheWriter(original, writer, err)
+		}
+	}
+}
+
+func flushCacheWriter(original http.ResponseWriter, writer *cacheWriter, err error) error {
+	original.WriteHeader(writer.status)
+
+	if _, writeErr := original.Write(writer.body.Bytes()); writeErr != nil && err == nil {
+		return writeErr
+	}
+
+	return err
+}
+
+func notModified(r *http.Request, header http.Header) bool {
+	if ifNoneMatch := r.Header.Get(HeaderIfNoneMatch); ifNoneMatch != "" {
+		etag := strings.TrimPrefix(header.Get(HeaderETag), "W/")
+
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(r.Header.Get(echo.HeaderIfModifiedSince))
+
+	if err != nil {
+		return false
+	}
+
+	modifiedAt, err := http.ParseTime(header.Get(echo.HeaderLastModified))
+
+	if err != nil {
+		return false
+	}
+
+	return !modifiedAt.After(ifModifiedSince)
+}
+
+func lastModified(c echo.Context, t time.Time) {
+	if !t.IsZero() {
+		c.Response().Header().Set(echo.HeaderLastModified, t.UTC().Format(http.TimeFormat))
+	}
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/MrAndreID/goapi/internal/services"
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/MrAndreID/gopackage"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type EmailHandlerOptions struct {
+	Cache       CachePolicy
+	Middlewares []echo.MiddlewareFunc
+}
+
+type emailHandler struct {
+	EmailService services.IEmailService
+	Options      EmailHandlerOptions
+}
+
+func NewEmailHandler(e *echo.Group, emailService services.IEmailService, options EmailHandlerOptions) *emailHandler {
+	handler := &emailHandler{
+		EmailService: emailService,
+		Options:      options,
+	}
+
+	middlewares := options.Middlewares
+	cache := append(slices.Clone(middlewares), CacheControl(options.Cache))
+
+	e.GET("/user/:id/emails", handler.Read, cache...)
+	e.POST("/user/:id/emails", handler.Create, middlewares...)
+	e.POST("/user/:id/emails/:emailId/verify", handler.Verify, middlewares...)
+	e.POST("/user/:id/emails/:emailId/verify/resend", handler.ResendVerification, middlewares...)
+	e.DELETE("/user/:id/emails/:emailId", handler.Delete, middlewares...)
+
+	return handler
+}
+
+func (h *emailHandler) Read(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.email.Read."
+		req types.ReadEmailRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	emails, err := h.EmailService.Read(c.Request().Context(), req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to read email (from email service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        emails,
+	})
+}
+
+func (h *emailHandler) Create(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.email.Create."
+		req types.CreateEmailRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	email, err := h.EmailService.Create(c.Request().Context(), req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to create email (from email service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusCreated),
+		Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusCreated), " ", "_")),
+		Data:        email,
+	})
+}
+
+func (h *emailHandler) Verify(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.email.Verify."
+		req types.VerifyEmailRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	email, err := h.EmailService.Verify(c.Request().Context(), req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to verify email (from email service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        email,
+	})
+}
+
+func (h *emailHandler) ResendVerification(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.email.ResendVerification."
+		req types.ResendEmailVerificationRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	if err := h.EmailService.ResendVerification(c.Request().Context(), req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to resend email verification (from email service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+	})
+}
+
+func (h *emailHandler) Delete(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.email.Delete."
+		req types.DeleteEmailRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	if err := h.EmailService.Delete(c.Request().Context(), req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to delete email (from email service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+	})
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/labstack/echo/v4"
+)
+
+func errorResponse(c echo.Context, err error) error {
+	var (
+		statusCode  int = http.StatusInternalServerError
+		domainError *types.Error
+		data        types.ErrorResponse
+	)
+
+	data.Error = strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusInternalServerError), " ", "_"))
+
+	if errors.As(err, &domainError) {
+		switch domainError.Kind {
+		case types.ErrorKindNotFound:
+			statusCode = http.StatusNotFound
+		case types.ErrorKindConflict:
+			statusCode = http.StatusConflict
+		case types.ErrorKindValidation:
+			statusCode = http.StatusUnprocessableEntity
+		case types.ErrorKindPreconditionFailed:
+			statusCode = http.StatusPreconditionFailed
+		case types.ErrorKindPreconditionRequired:
+			statusCode = http.StatusPreconditionRequired
+		}
+
+		data.Error = domainError.Code
+		data.Details = domainError.Details
+	}
+
+	return c.JSON(statusCode, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", statusCode),
+		Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(statusCode), " ", "_")),
+		Data:        data,
+	})
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/MrAndreID/goapi/internal/repositories"
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag    string = "ETag"
+	HeaderIfMatch string = "If-Match"
+)
+
+var (
+	ErrIfMatchRequired = types.NewPreconditionRequiredError("IF_MATCH_REQUIRED")
+	ErrVersionRequired = types.NewPreconditionRequiredError("VERSION_REQUIRED")
+)
+
+func versionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+func ifMatchVersion(c echo.Context, required bool) (*int64, error) {
+	header := strings.TrimSpace(c.Request().Header.Get(HeaderIfMatch))
+
+	if header == "" {
+		if required {
+			return nil, ErrIfMatchRequired
+		}
+
+		return nil, nil
+	}
+
+	if header == "*" {
+		return nil, nil
+	}
+
+	if len(header) < 2 || !strings.HasPrefix(header, `"`) || !strings.HasSuffix(header, `"`) {
+		return nil, repositories.ErrVersionMismatch
+	}
+
+	version, err := strconv.ParseInt(header[1:len(header)-1], 10, 64)
+
+	if err != nil {
+		return nil, repositories.ErrVersionMismatch
+	}
+
+	return &version, nil
+}
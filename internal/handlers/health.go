@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MrAndreID/goapi/internal/services"
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type healthHandler struct {
+	HealthService services.IHealthService
+}
+
+func NewHealthHandler(e *echo.Echo, healthService services.IHealthService) *healthHandler {
+	handler := &healthHandler{
+		HealthService: healthService,
+	}
+
+	e.GET("/healthz", handler.Live)
+	e.GET("/readyz", handler.Ready)
+
+	return handler
+}
+
+func (h *healthHandler) Live(c echo.Context) error {
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        h.HealthService.Live(),
+	})
+}
+
+func (h *healthHandler) Ready(c echo.Context) error {
+	var tag string = "internal.handlers.health.Ready."
+
+	healthData := h.HealthService.Ready(c.Request().Context())
+
+	if healthData.Status == services.HealthStatusDown {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": "Service Unavailable",
+		}).Error("failed to pass readiness check (from health service)")
+
+		return c.JSON(http.StatusServiceUnavailable, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusServiceUnavailable),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusServiceUnavailable), " ", "_")),
+			Data:        healthData,
+		})
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        healthData,
+	})
+}
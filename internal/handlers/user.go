@@ -3,8 +3,12 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/MrAndreID/goapi/databases/models"
+	"github.com/MrAndreID/goapi/exports"
 	"github.com/MrAndreID/goapi/internal/services"
 	"github.com/MrAndreID/goapi/internal/types"
 
@@ -13,19 +17,36 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+type UserHandlerOptions struct {
+	RequireIfMatch bool
+	Cache          CachePolicy
+	Middlewares    []echo.MiddlewareFunc
+}
+
 type userHandler struct {
 	UserService services.IUserService
+	Options     UserHandlerOptions
 }
 
-func NewUserHandler(e *echo.Group, userService services.IUserService) *userHandler {
+func NewUserHandler(e *echo.Group, userService services.IUserService, options UserHandlerOptions) *userHandler {
 	handler := &userHandler{
 		UserService: userService,
+		Options:     options,
 	}
 
-	e.POST("/user", handler.Create)
-	e.GET("/user", handler.Read)
-	e.PATCH("/user/:id", handler.Update)
-	e.DELETE("/user/:id", handler.Delete)
+	middlewares := options.Middlewares
+	cache := append(slices.Clone(middlewares), CacheControl(options.Cache))
+
+	e.POST("/user", handler.Create, middlewares...)
+	e.GET("/user", handler.Read, cache...)
+	e.GET("/user/export", handler.Export, middlewares...)
+	e.POST("/user/import", handler.Import, middlewares...)
+	e.POST("/user/bulk", handler.BulkCreate, middlewares...)
+	e.PATCH("/user/bulk", handler.BulkUpdate, middlewares...)
+	e.DELETE("/user/bulk", handler.BulkDelete, middlewares...)
+	e.GET("/user/:id", handler.Find, cache...)
+	e.PATCH("/user/:id", handler.Update, middlewares...)
+	e.DELETE("/user/:id", handler.Delete, middlewares...)
 
 	return handler
 }
@@ -60,10 +81,7 @@ func (h *userHandler) Create(c echo.Context) error {
 			"error": err.Error(),
 		}).Error("failed to create user (from user service)")
 
-		return c.JSON(http.StatusInternalServerError, types.MainResponse{
-			Code:        fmt.Sprintf("%04d", http.StatusInternalServerError),
-			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusInternalServerError), " ", "_")),
-		})
+		return errorResponse(c, err)
 	}
 
 	return c.JSON(http.StatusCreated, types.MainResponse{
@@ -79,6 +97,8 @@ func (h *userHandler) Read(c echo.Context) error {
 		req types.ReadUserRequest
 	)
 
+	req.Filter = types.ParseFilters(c.QueryParams())
+
 	if err := gopackage.EchoBindRequest(c, &req); err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "01",
@@ -100,10 +120,7 @@ func (h *userHandler) Read(c echo.Context) error {
 			"error": err.Error(),
 		}).Error("failed to get user (from user service)")
 
-		return c.JSON(http.StatusInternalServerError, types.MainResponse{
-			Code:        fmt.Sprintf("%04d", http.StatusInternalServerError),
-			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusInternalServerError), " ", "_")),
-		})
+		return errorResponse(c, err)
 	}
 
 	return c.JSON(http.StatusOK, types.MainResponse{
@@ -113,6 +130,362 @@ func (h *userHandler) Read(c echo.Context) error {
 	})
 }
 
+func (h *userHandler) Export(c echo.Context) error {
+	var (
+		tag    string = "internal.handlers.user.Export."
+		req    types.ExportUserRequest
+		writer exports.Writer
+	)
+
+	req.Filter = types.ParseFilters(c.QueryParams())
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	start := func() error {
+		var err error
+
+		c.Response().Header().Set(echo.HeaderContentType, exports.ContentType(req.Format))
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="users-%s.%s"`, time.Now().Format("20060102150405"), req.Format))
+		c.Response().WriteHeader(http.StatusOK)
+
+		writer, err = exports.New(req.Format, c.Response())
+
+		if err != nil {
+			return err
+		}
+
+		return writer.Write([]string{"id", "name", "emails", "createdAt", "updatedAt"})
+	}
+
+	err := h.UserService.Export(c.Request().Context(), req, func(users []models.User) error {
+		if writer == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		for _, user := range users {
+			emails := make([]string, len(user.Emails))
+
+			for i, email := range user.Emails {
+				emails[i] = email.Email
+			}
+
+			if err := writer.Write([]string{
+				user.ID,
+				user.Name,
+				strings.Join(emails, ";"),
+				user.CreatedAt.Format(time.RFC3339),
+				user.UpdatedAt.Format(time.RFC3339),
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		c.Response().Flush()
+
+		return nil
+	})
+
+	if err != nil && writer == nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to export user (from user service)")
+
+		return errorResponse(c, err)
+	}
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to export user, the download is truncated")
+
+		return nil
+	}
+
+	if writer == nil {
+		if err := start(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": err.Error(),
+			}).Error("failed to start export")
+
+			return nil
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "05",
+			"error": err.Error(),
+		}).Error("failed to close export writer")
+	}
+
+	return nil
+}
+
+func (h *userHandler) Import(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.user.Import."
+		req types.ImportUserRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	fileHeader, err := c.FormFile("file")
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data: map[string]string{
+				"file": "cannot be blank",
+			},
+		})
+	}
+
+	file, err := fileHeader.Open()
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to open uploaded file")
+
+		return errorResponse(c, err)
+	}
+
+	defer file.Close()
+
+	report, err := h.UserService.Import(c.Request().Context(), file, req.DryRun == "true")
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "04",
+			"error": err.Error(),
+		}).Error("failed to import user (from user service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        report,
+	})
+}
+
+func (h *userHandler) BulkCreate(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.user.BulkCreate."
+		req types.BulkCreateUserRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	report, err := h.UserService.BulkCreate(c.Request().Context(), req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to bulk create user (from user service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        report,
+	})
+}
+
+func (h *userHandler) BulkUpdate(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.user.BulkUpdate."
+		req types.BulkUpdateUserRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	if h.Options.RequireIfMatch {
+		for i, item := range req.Items {
+			if item.Version == nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "02",
+					"index": i,
+				}).Error("bulk item has no version")
+
+				return errorResponse(c, ErrVersionRequired.WithDetails(map[string]any{"index": i}))
+			}
+		}
+	}
+
+	report, err := h.UserService.BulkUpdate(c.Request().Context(), req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to bulk update user (from user service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        report,
+	})
+}
+
+func (h *userHandler) BulkDelete(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.user.BulkDelete."
+		req types.BulkDeleteUserRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	if h.Options.RequireIfMatch {
+		for i, id := range req.IDs {
+			if _, ok := req.Versions[id]; !ok {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "02",
+					"index": i,
+				}).Error("bulk item has no version")
+
+				return errorResponse(c, ErrVersionRequired.WithDetails(map[string]any{"index": i}))
+			}
+		}
+	}
+
+	report, err := h.UserService.BulkDelete(c.Request().Context(), req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to bulk delete user (from user service)")
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        report,
+	})
+}
+
+func (h *userHandler) Find(c echo.Context) error {
+	var (
+		tag string = "internal.handlers.user.Find."
+		req types.FindUserRequest
+	)
+
+	if err := gopackage.EchoBindRequest(c, &req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.(*echo.HTTPError).Message,
+		}).Error("invalid request data")
+
+		return c.JSON(http.StatusBadRequest, types.MainResponse{
+			Code:        fmt.Sprintf("%04d", http.StatusBadRequest),
+			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusBadRequest), " ", "_")),
+			Data:        err.(*echo.HTTPError).Message,
+		})
+	}
+
+	user, err := h.UserService.Find(c.Request().Context(), req.ID)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to find user (from user service)")
+
+		return errorResponse(c, err)
+	}
+
+	c.Response().Header().Set(HeaderETag, versionETag(user.Version))
+
+	lastModified(c, user.UpdatedAt)
+
+	return c.JSON(http.StatusOK, types.MainResponse{
+		Code:        fmt.Sprintf("%04d", http.StatusOK),
+		Description: "SUCCESS",
+		Data:        user,
+	})
+}
+
 func (h *userHandler) Update(c echo.Context) error {
 	var (
 		tag string = "internal.handlers.user.Update."
@@ -132,20 +505,32 @@ func (h *userHandler) Update(c echo.Context) error {
 		})
 	}
 
-	err := h.UserService.Update(req)
+	version, err := ifMatchVersion(c, h.Options.RequireIfMatch)
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "02",
 			"error": err.Error(),
+		}).Error("invalid if-match header")
+
+		return errorResponse(c, err)
+	}
+
+	req.Version = version
+
+	user, err := h.UserService.Update(req)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
 		}).Error("failed to update user (from user service)")
 
-		return c.JSON(http.StatusInternalServerError, types.MainResponse{
-			Code:        fmt.Sprintf("%04d", http.StatusInternalServerError),
-			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusInternalServerError), " ", "_")),
-		})
+		return errorResponse(c, err)
 	}
 
+	c.Response().Header().Set(HeaderETag, versionETag(user.Version))
+
 	return c.JSON(http.StatusOK, types.MainResponse{
 		Code:        fmt.Sprintf("%04d", http.StatusOK),
 		Description: "SUCCESS",
@@ -171,16 +556,26 @@ func (h *userHandler) Delete(c echo.Context) error {
 		})
 	}
 
-	if err := h.UserService.Delete(req.ID); err != nil {
+	version, err := ifMatchVersion(c, h.Options.RequireIfMatch)
+
+	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "02",
 			"error": err.Error(),
+		}).Error("invalid if-match header")
+
+		return errorResponse(c, err)
+	}
+
+	req.Version = version
+
+	if err := h.UserService.Delete(req); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
 		}).Error("failed to delete user (from user service)")
 
-		return c.JSON(http.StatusInternalServerError, types.MainResponse{
-			Code:        fmt.Sprintf("%04d", http.StatusInternalServerError),
-			Description: strings.ToUpper(strings.ReplaceAll(http.StatusText(http.StatusInternalServerError), " ", "_")),
-		})
+		return errorResponse(c, err)
 	}
 
 	return c.JSON(http.StatusOK, types.MainResponse{
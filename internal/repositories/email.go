@@ -0,0 +1,467 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MrAndreID/goapi/databases/models"
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrEmailNotFound      = types.NewNotFoundError("EMAIL_NOT_FOUND")
+	ErrEmailAlreadyExists = types.NewConflictError("EMAIL_ALREADY_EXISTS")
+	ErrEmailAlreadyTaken  = types.NewConflictError("EMAIL_ALREADY_TAKEN")
+	ErrLastEmail          = types.NewValidationError("CANNOT_DELETE_LAST_EMAIL")
+)
+
+type IEmailRepository interface {
+	Read(context.Context, string) ([]models.Email, error)
+	Create(context.Context, CreateEmailData) (models.Email, error)
+	Find(context.Context, string, string) (models.Email, error)
+	Verify(context.Context, string, string) (models.Email, error)
+	Delete(context.Context, string, string) error
+}
+
+type EmailRepository struct {
+	TimeLocation *time.Location
+	Database     *gorm.DB
+}
+
+func NewEmailRepository(timeLocation *time.Location, db *gorm.DB) *EmailRepository {
+	return &EmailRepository{
+		TimeLocation: timeLocation,
+		Database:     db,
+	}
+}
+
+type CreateEmailData struct {
+	UserID  string
+	Email   string
+	Primary bool
+}
+
+func (r *EmailRepository) Read(ctx context.Context, userID string) ([]models.Email, error) {
+	var (
+		tag    string = "internal.repositories.email.Read."
+		emails []models.Email
+	)
+
+	if err := r.findUser(r.Database.WithContext(ctx), userID, false); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to read user data")
+
+		return emails, err
+	}
+
+	readEmail := r.Database.WithContext(ctx).Order("is_primary desc, created_at asc, id asc").Find(&emails, "user_id = ?", userID)
+
+	if readEmail.Error != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": readEmail.Error.Error(),
+		}).Error("failed to read email data")
+
+		return emails, readEmail.Error
+	}
+
+	return emails, nil
+}
+
+func (r *EmailRepository) Create(ctx context.Context, req CreateEmailData) (models.Email, error) {
+	var (
+		tag   string = "internal.repositories.email.Create."
+		email models.Email
+	)
+
+	err := r.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var (
+			emails  []models.Email
+			primary bool
+		)
+
+		if err := r.findUser(tx, req.UserID, true); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to read user data")
+
+			return err
+		}
+
+		if err := tx.Find(&emails, "user_id = ?", req.UserID).Error; err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": err.Error(),
+			}).Error("failed to read email data")
+
+			return err
+		}
+
+		for _, v := range emails {
+			if strings.EqualFold(v.Email, req.Email) {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "03",
+					"error": "Email Already Exists",
+				}).Error("email already exists for user")
+
+				return ErrEmailAlreadyExists.WithDetails(map[string]string{"email": req.Email})
+			}
+
+			primary = primary || v.Primary
+		}
+
+		if req.Primary && primary {
+			if err := tx.Model(&models.Email{}).Where("user_id = ? AND is_primary = ?", req.UserID, true).Update("is_primary", false).Error; err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "04",
+					"error": err.Error(),
+				}).Error("failed to unset primary email")
+
+				return err
+			}
+		}
+
+		emailUUID, err := uuid.NewRandom()
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "05",
+				"error": err.Error(),
+			}).Error("failed to generate uuid")
+
+			return err
+		}
+
+		email.ID = emailUUID.String()
+		email.CreatedAt = time.Now().In(r.TimeLocation)
+		email.UpdatedAt = time.Now().In(r.TimeLocation)
+		email.UserID = req.UserID
+		email.Email = req.Email
+		email.Primary = req.Primary || !primary
+
+		createEmail := tx.Create(&email)
+
+		if createEmail.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "06",
+				"error": createEmail.Error.Error(),
+			}).Error("failed to create email")
+
+			return createEmail.Error
+		}
+
+		if createEmail.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "07",
+				"error": "Failed to Create Email",
+			}).Error("failed to create email")
+
+			return ErrFailedToCreateEmail
+		}
+
+		if err := touchUser(tx, req.UserID, email.UpdatedAt); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "08",
+				"error": err.Error(),
+			}).Error("failed to update user version")
+
+			return err
+		}
+
+		return nil
+	})
+
+	return email, emailConflict(r.Database.WithContext(ctx), req.UserID, []string{req.Email}, err)
+}
+
+func (r *EmailRepository) Find(ctx context.Context, userID string, emailID string) (models.Email, error) {
+	var (
+		tag   string = "internal.repositories.email.Find."
+		email models.Email
+	)
+
+	if err := r.findUser(r.Database.WithContext(ctx), userID, false); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to read user data")
+
+		return email, err
+	}
+
+	readEmail := r.Database.WithContext(ctx).Limit(1).Find(&email, "id = ? AND user_id = ?", emailID, userID)
+
+	if readEmail.Error != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": readEmail.Error.Error(),
+		}).Error("failed to read email data")
+
+		return email, readEmail.Error
+	}
+
+	if readEmail.RowsAffected == 0 {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": "Email Not Found",
+		}).Error("email not found for user")
+
+		return email, ErrEmailNotFound
+	}
+
+	return email, nil
+}
+
+func (r *EmailRepository) Verify(ctx context.Context, userID string, emailID string) (models.Email, error) {
+	var (
+		tag   string = "internal.repositories.email.Verify."
+		email models.Email
+	)
+
+	err := r.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		readEmail := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Limit(1).Find(&email, "id = ? AND user_id = ?", emailID, userID)
+
+		if readEmail.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": readEmail.Error.Error(),
+			}).Error("failed to read email data")
+
+			return readEmail.Error
+		}
+
+		if readEmail.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": "Email Not Found",
+			}).Error("email not found for user")
+
+			return ErrEmailNotFound
+		}
+
+		if email.VerifiedAt != nil {
+			return nil
+		}
+
+		now := time.Now().In(r.TimeLocation)
+
+		updateEmail := tx.Model(&email).Updates(map[string]any{
+			"verified_at": now,
+			"updated_at":  now,
+		})
+
+		if updateEmail.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": updateEmail.Error.Error(),
+			}).Error("failed to verify email")
+
+			return updateEmail.Error
+		}
+
+		email.VerifiedAt = &now
+		email.UpdatedAt = now
+
+		if err := touchUser(tx, userID, now); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": err.Error(),
+			}).Error("failed to update user version")
+
+			return err
+		}
+
+		return nil
+	})
+
+	return email, err
+}
+
+func (r *EmailRepository) Delete(ctx context.Context, userID string, emailID string) error {
+	var tag string = "internal.repositories.email.Delete."
+
+	return r.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var emails []models.Email
+
+		if err := r.findUser(tx, userID, true); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to read user data")
+
+			return err
+		}
+
+		if err := tx.Order("created_at asc, id asc").Find(&emails, "user_id = ?", userID).Error; err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": err.Error(),
+			}).Error("failed to read email data")
+
+			return err
+		}
+
+		index := -1
+
+		for i, v := range emails {
+			if v.ID == emailID {
+				index = i
+			}
+		}
+
+		if index == -1 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": "Email Not Found",
+			}).Error("email not found for user")
+
+			return ErrEmailNotFound
+		}
+
+		if len(emails) == 1 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": "Cannot Delete Last Email",
+			}).Error("cannot delete the last email of user")
+
+			return ErrLastEmail
+		}
+
+		deleteEmail := tx.Delete(&emails[index])
+
+		if deleteEmail.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "05",
+				"error": deleteEmail.Error.Error(),
+			}).Error("failed to delete email data")
+
+			return deleteEmail.Error
+		}
+
+		if deleteEmail.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "06",
+				"error": "Failed to Delete Email Data",
+			}).Error("failed to delete email data")
+
+			return ErrFailedToDeleteEmail
+		}
+
+		if err := touchUser(tx, userID, time.Now().In(r.TimeLocation)); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "07",
+				"error": err.Error(),
+			}).Error("failed to update user version")
+
+			return err
+		}
+
+		if !emails[index].Primary {
+			return nil
+		}
+
+		next := emails[0]
+
+		if index == 0 {
+			next = emails[1]
+		}
+
+		if err := tx.Model(&next).Update("is_primary", true).Error; err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "08",
+				"error": err.Error(),
+			}).Error("failed to promote primary email")
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (r *EmailRepository) findUser(tx *gorm.DB, userID string, lock bool) error {
+	var user models.User
+
+	if lock {
+		tx = tx.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
+	readUser := tx.Limit(1).Find(&user, "id = ?", userID)
+
+	if readUser.Error != nil {
+		return readUser.Error
+	}
+
+	if readUser.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func emailConflict(db *gorm.DB, userID string, emails []string, err error) error {
+	if !isEmailUniqueViolation(db, err) {
+		return err
+	}
+
+	var taken []models.Email
+
+	lowered := make([]string, len(emails))
+
+	for i, v := range emails {
+		lowered[i] = strings.ToLower(v)
+	}
+
+	readEmail := db.Find(&taken, "lower(email) IN ? AND user_id <> ?", lowered, userID)
+
+	if readEmail.Error == nil {
+		for _, v := range emails {
+			for _, t := range taken {
+				if strings.EqualFold(v, t.Email) {
+					return ErrEmailAlreadyTaken.WithDetails(map[string]string{"email": v})
+				}
+			}
+		}
+	}
+
+	for i := 0; i < len(lowered); i++ {
+		for j := i + 1; j < len(lowered); j++ {
+			if lowered[i] == lowered[j] {
+				return ErrEmailAlreadyTaken.WithDetails(map[string]string{"email": emails[j]})
+			}
+		}
+	}
+
+	return ErrEmailAlreadyTaken
+}
+
+func isEmailUniqueViolation(db *gorm.DB, err error) bool {
+	if err == nil || !strings.Contains(err.Error(), "idx_emails_email_unique") {
+		return false
+	}
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
+
+	translator, ok := db.Dialector.(gorm.ErrorTranslator)
+
+	return ok && errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey)
+}
+
+func touchUser(tx *gorm.DB, userID string, updatedAt time.Time) error {
+	return tx.Model(&models.User{}).Where("id = ?", userID).Updates(map[string]any{
+		"updated_at": updatedAt,
+		"version":    gorm.Expr("version + 1"),
+	}).Error
+}
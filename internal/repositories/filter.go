@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"strings"
+
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidFilter      = types.NewValidationError("INVALID_FILTER")
+	ErrInvalidSearchField = types.NewValidationError("INVALID_SEARCH_FIELD")
+	ErrInvalidField       = types.NewValidationError("INVALID_FIELD")
+	ErrInvalidSort        = types.NewValidationError("INVALID_SORT")
+)
+
+func filterScope(column string, operator string, value any) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch operator {
+		case types.FilterEqual:
+			return db.Where(column+" = ?", value)
+		case types.FilterNotEqual:
+			return db.Where(column+" <> ?", value)
+		case types.FilterLike:
+			return db.Where("lower("+column+") like ?", "%"+strings.ToLower(cast.ToString(value))+"%")
+		case types.FilterIn:
+			return db.Where(column+" in ?", strings.Split(cast.ToString(value), ","))
+		case types.FilterGreaterThan:
+			return db.Where(column+" > ?", value)
+		case types.FilterGreaterThanEqual:
+			return db.Where(column+" >= ?", value)
+		case types.FilterLessThan:
+			return db.Where(column+" < ?", value)
+		case types.FilterLessThanEqual:
+			return db.Where(column+" <= ?", value)
+		}
+
+		db.AddError(ErrInvalidFilter)
+
+		return db
+	}
+}
+
+func associationScope(db *gorm.DB, foreignKey string, model any, scope func(*gorm.DB) *gorm.DB) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		return tx.Where("id in (?)", db.Model(model).Select(foreignKey).Scopes(scope))
+	}
+}
+
+func orScope(db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		group := db.Session(&gorm.Session{NewDB: true})
+
+		for i, scope := range scopes {
+			condition := scope(db.Session(&gorm.Session{NewDB: true}))
+
+			if i == 0 {
+				group = group.Where(condition)
+			} else {
+				group = group.Or(condition)
+			}
+		}
+
+		return tx.Where(group)
+	}
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/MrAndreID/goapi/internal/types"
+)
+
+var (
+	ErrInvalidCursor = types.NewValidationError("INVALID_CURSOR")
+)
+
+func reverseSort(sort string) string {
+	if sort == "desc" {
+		return "asc"
+	}
+
+	return "desc"
+}
+
+func keysetCondition(columns []string, sorts []string, values []any) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	for i := range columns {
+		var parts []string
+
+		for j := 0; j < i; j++ {
+			parts = append(parts, columns[j]+" = ?")
+			args = append(args, values[j])
+		}
+
+		operator := " > ?"
+
+		if sorts[i] == "desc" {
+			operator = " < ?"
+		}
+
+		parts = append(parts, columns[i]+operator)
+		args = append(args, values[i])
+
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return strings.Join(conditions, " OR "), args
+}
+
+func orderColumns(columns map[string]string, sorts []types.SortField, tieBreaker string, reverse bool) ([]string, []string, []string) {
+	var keys, orders, directions []string
+
+	if !slices.ContainsFunc(sorts, func(sort types.SortField) bool { return sort.Field == tieBreaker }) {
+		sorts = append(slices.Clone(sorts), types.SortField{Field: tieBreaker})
+	}
+
+	for _, sort := range sorts {
+		direction := "asc"
+
+		if sort.Desc {
+			direction = "desc"
+		}
+
+		if reverse {
+			direction = reverseSort(direction)
+		}
+
+		keys = append(keys, sort.Field)
+		orders = append(orders, columns[sort.Field])
+		directions = append(directions, direction)
+
+		if sort.Field == tieBreaker {
+			break
+		}
+	}
+
+	return keys, orders, directions
+}
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	tsQueryPattern      = regexp.MustCompile(`[&|!():*<>'\\]+`)
+	booleanQueryPattern = regexp.MustCompile(`[+\-<>()~*"@.]+`)
+)
+
+func fullTextSupported(dialect string) bool {
+	return dialect == "postgres" || dialect == "mysql"
+}
+
+func fullTextQuery(dialect string, search string) string {
+	var terms []string
+
+	switch dialect {
+	case "postgres":
+		for _, term := range strings.Fields(tsQueryPattern.ReplaceAllString(search, " ")) {
+			terms = append(terms, term+":*")
+		}
+
+		return strings.Join(terms, " & ")
+	case "mysql":
+		for _, term := range strings.Fields(booleanQueryPattern.ReplaceAllString(search, " ")) {
+			terms = append(terms, "+"+term+"*")
+		}
+
+		return strings.Join(terms, " ")
+	}
+
+	return ""
+}
+
+func fullTextMatch(dialect string, table string, column string) string {
+	if dialect == "postgres" {
+		return table + ".search_vector @@ to_tsquery('simple', ?)"
+	}
+
+	return "match(" + table + "." + column + ") against (? in boolean mode)"
+}
+
+func fullTextRank(dialect string, table string, column string) string {
+	if dialect == "postgres" {
+		return "ts_rank(" + table + ".search_vector, to_tsquery('simple', ?))"
+	}
+
+	return "match(" + table + "." + column + ") against (? in boolean mode)"
+}
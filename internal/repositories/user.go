@@ -3,6 +3,8 @@ package repositories
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/MrAndreID/goapi/databases/models"
@@ -11,25 +13,47 @@ import (
 	"github.com/MrAndreID/gopackage"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/cast"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ExportChunkSize int = 1000
+
+var (
+	ErrUserNotFound        = types.NewNotFoundError("USER_NOT_FOUND")
+	ErrFailedToCreateUser  = types.NewInternalError("FAILED_TO_CREATE_USER")
+	ErrFailedToCreateEmail = types.NewInternalError("FAILED_TO_CREATE_EMAIL")
+	ErrFailedToReadEmail   = types.NewInternalError("FAILED_TO_READ_EMAIL_DATA")
+	ErrFailedToUpdateUser  = types.NewInternalError("FAILED_TO_UPDATE_USER_DATA")
+	ErrFailedToDeleteUser  = types.NewInternalError("FAILED_TO_DELETE_USER_DATA")
+	ErrFailedToDeleteEmail = types.NewInternalError("FAILED_TO_DELETE_EMAIL_DATA")
+	ErrVersionMismatch     = types.NewPreconditionFailedError("VERSION_MISMATCH")
 )
 
 type IUserRepository interface {
 	Create(CreateUserData) (models.User, error)
+	CreateBatch(context.Context, []CreateUserData) ([]models.Email, error)
+	TakenEmails(context.Context, []string) (map[string]bool, error)
 	Read(context.Context, ReadUserData) (types.PaginatorResponse, error)
-	Update(UpdateUserData) error
-	Delete(string) error
+	Export(context.Context, ReadUserData, func([]models.User) error) error
+	Find(context.Context, string) (models.User, error)
+	Update(UpdateUserData) (models.User, []models.Email, error)
+	Delete(string, *int64) error
+	Transaction(context.Context, func(IUserRepository) error) error
 }
 
 type UserRepository struct {
-	TimeLocation *time.Location
-	Database     *gorm.DB
+	TimeLocation   *time.Location
+	Database       *gorm.DB
+	FullTextSearch bool
 }
 
-func NewUserRepository(timeLocation *time.Location, db *gorm.DB) *UserRepository {
+func NewUserRepository(timeLocation *time.Location, db *gorm.DB, fullTextSearch bool) *UserRepository {
 	return &UserRepository{
-		TimeLocation: timeLocation,
-		Database:     db,
+		TimeLocation:   timeLocation,
+		Database:       db,
+		FullTextSearch: fullTextSearch,
 	}
 }
 
@@ -40,6 +64,14 @@ type ReadUserData struct {
 	SortBy                string
 	Search                string
 	DisableCalculateTotal bool
+	Cursor                bool
+	After                 *types.Cursor
+	Before                *types.Cursor
+	SearchFields          []string
+	Filters               []types.Filter
+	Fields                []string
+	Include               []string
+	Sort                  []types.SortField
 	ID                    string
 }
 
@@ -49,9 +81,10 @@ type CreateUserData struct {
 }
 
 type UpdateUserData struct {
-	ID     string
-	Name   string
-	Emails []string
+	ID      string
+	Name    string
+	Emails  []string
+	Version *int64
 }
 
 func (r *UserRepository) Create(req CreateUserData) (models.User, error) {
@@ -60,125 +93,362 @@ func (r *UserRepository) Create(req CreateUserData) (models.User, error) {
 		user models.User
 	)
 
-	tx := r.Database.Begin()
+	err := r.Database.Transaction(func(tx *gorm.DB) error {
+		userUUID, err := uuid.NewRandom()
 
-	userUUID, err := uuid.NewRandom()
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to generate uuid")
 
-	if err != nil {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "01",
-			"error": err.Error(),
-		}).Error("failed to generate uuid")
+			return err
+		}
 
-		tx.Rollback()
+		user.ID = userUUID.String()
+		user.CreatedAt = time.Now().In(r.TimeLocation)
+		user.UpdatedAt = time.Now().In(r.TimeLocation)
+		user.Name = req.Name
+		user.Version = 1
 
-		return user, err
-	}
+		createUser := tx.Save(&user)
 
-	user.ID = userUUID.String()
-	user.CreatedAt = time.Now().In(r.TimeLocation)
-	user.UpdatedAt = time.Now().In(r.TimeLocation)
-	user.Name = req.Name
+		if createUser.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": createUser.Error.Error(),
+			}).Error("failed to create user")
 
-	createUser := tx.Save(&user)
+			return createUser.Error
+		}
 
-	if createUser.Error != nil {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "02",
-			"error": createUser.Error.Error(),
-		}).Error("failed to create user")
+		if createUser.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": "Failed to Create User",
+			}).Error("failed to create user")
 
-		tx.Rollback()
+			return ErrFailedToCreateUser
+		}
 
-		return user, createUser.Error
-	}
+		for i, v := range req.Emails {
+			var email models.Email
 
-	if createUser.RowsAffected == 0 {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "03",
-			"error": "Failed to Create User",
-		}).Error("failed to create user")
+			emailUUID, err := uuid.NewRandom()
 
-		tx.Rollback()
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "04",
+					"error": err.Error(),
+				}).Error("failed to generate uuid")
 
-		return user, errors.New("FAILED_TO_CREATE_USER")
-	}
+				return err
+			}
+
+			email.ID = emailUUID.String()
+			email.CreatedAt = time.Now().In(r.TimeLocation)
+			email.UpdatedAt = time.Now().In(r.TimeLocation)
+			email.UserID = user.ID
+			email.Email = v
+			email.Primary = i == 0
+
+			createEmail := tx.Save(&email)
+
+			if createEmail.Error != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "05",
+					"error": createEmail.Error.Error(),
+				}).Error("failed to create email")
+
+				return createEmail.Error
+			}
+
+			if createEmail.RowsAffected == 0 {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "06",
+					"error": "Failed to Create Email",
+				}).Error("failed to create email")
+
+				return ErrFailedToCreateEmail
+			}
 
-	for _, v := range req.Emails {
-		var email models.Email
+			user.Emails = append(user.Emails, email)
+		}
 
-		emailUUID, err := uuid.NewRandom()
+		return nil
+	})
+
+	return user, emailConflict(r.Database, user.ID, req.Emails, err)
+}
+
+func (r *UserRepository) CreateBatch(ctx context.Context, req []CreateUserData) ([]models.Email, error) {
+	var (
+		tag    string = "internal.repositories.user.CreateBatch."
+		users  []models.User
+		emails []models.Email
+	)
+
+	now := time.Now().In(r.TimeLocation)
+
+	for _, data := range req {
+		userUUID, err := uuid.NewRandom()
 
 		if err != nil {
 			logrus.WithFields(logrus.Fields{
-				"tag":   tag + "04",
+				"tag":   tag + "01",
 				"error": err.Error(),
 			}).Error("failed to generate uuid")
 
-			tx.Rollback()
-
-			return user, err
+			return nil, err
 		}
 
-		email.ID = emailUUID.String()
-		email.CreatedAt = time.Now().In(r.TimeLocation)
-		email.UpdatedAt = time.Now().In(r.TimeLocation)
-		email.UserID = user.ID
-		email.Email = v
+		users = append(users, models.User{
+			ID:        userUUID.String(),
+			CreatedAt: now,
+			UpdatedAt: now,
+			Name:      data.Name,
+			Version:   1,
+		})
 
-		createEmail := tx.Save(&email)
+		for i, v := range data.Emails {
+			emailUUID, err := uuid.NewRandom()
 
-		if createEmail.Error != nil {
-			logrus.WithFields(logrus.Fields{
-				"tag":   tag + "05",
-				"error": createEmail.Error.Error(),
-			}).Error("failed to create email")
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "02",
+					"error": err.Error(),
+				}).Error("failed to generate uuid")
 
-			tx.Rollback()
+				return nil, err
+			}
 
-			return user, createEmail.Error
+			emails = append(emails, models.Email{
+				ID:        emailUUID.String(),
+				CreatedAt: now,
+				UpdatedAt: now,
+				UserID:    userUUID.String(),
+				Email:     v,
+				Primary:   i == 0,
+			})
 		}
+	}
 
-		if createEmail.RowsAffected == 0 {
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	err := r.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&users).Error; err != nil {
 			logrus.WithFields(logrus.Fields{
-				"tag":   tag + "06",
-				"error": "Failed to Create Email",
-			}).Error("failed to create email")
+				"tag":   tag + "03",
+				"error": err.Error(),
+			}).Error("failed to create user batch")
 
-			tx.Rollback()
+			return err
+		}
 
-			return user, errors.New("FAILED_TO_CREATE_EMAIL")
+		if len(emails) == 0 {
+			return nil
 		}
 
-		user.Emails = append(user.Emails, email)
+		if err := tx.Create(&emails).Error; err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": err.Error(),
+			}).Error("failed to create email batch")
+
+			return err
+		}
+
+		return nil
+	})
+
+	addresses := make([]string, len(emails))
+
+	for i, v := range emails {
+		addresses[i] = v.Email
 	}
 
-	tx.Commit()
+	if err := emailConflict(r.Database.WithContext(ctx), "", addresses, err); err != nil {
+		return nil, err
+	}
 
-	return user, nil
+	return emails, nil
+}
+
+func (r *UserRepository) TakenEmails(ctx context.Context, addresses []string) (map[string]bool, error) {
+	var (
+		tag   string          = "internal.repositories.user.TakenEmails."
+		taken map[string]bool = map[string]bool{}
+		found []string
+	)
+
+	if len(addresses) == 0 {
+		return taken, nil
+	}
+
+	lowered := make([]string, len(addresses))
+
+	for i, address := range addresses {
+		lowered[i] = strings.ToLower(address)
+	}
+
+	readEmail := r.Database.WithContext(ctx).Model(&models.Email{}).Where("lower(email) IN ?", lowered).Pluck("email", &found)
+
+	if readEmail.Error != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": readEmail.Error.Error(),
+		}).Error("failed to read taken emails")
+
+		return nil, ErrFailedToReadEmail
+	}
+
+	for _, address := range found {
+		taken[strings.ToLower(address)] = true
+	}
+
+	return taken, nil
 }
 
 func (r *UserRepository) Read(ctx context.Context, req ReadUserData) (types.PaginatorResponse, error) {
 	var (
+		tag     string = "internal.repositories.user.Read."
 		users   []models.User
-		orderBy map[string]string = map[string]string{
+		orderBy map[string]string = types.UserSortFields
+		sortBy  map[string]string = map[string]string{
+			"asc":  "asc",
+			"desc": "desc",
+		}
+		filterBy map[string]string = map[string]string{
+			"id":           "id",
+			"name":         "name",
+			"createdAt":    "created_at",
+			"updatedAt":    "updated_at",
+			"emails.email": "email",
+		}
+		selectBy map[string]string = map[string]string{
 			"id":        "id",
 			"name":      "name",
 			"createdAt": "created_at",
 			"updatedAt": "updated_at",
+			"version":   "version",
 		}
-		sortBy map[string]string = map[string]string{
-			"asc":  "asc",
-			"desc": "desc",
+		searchBy map[string]string = map[string]string{
+			"name":         "name",
+			"emails.email": "email",
 		}
-		search []string = []string{"name"}
-		total  int64
-		res    types.PaginatorResponse
+		scopes   []func(*gorm.DB) *gorm.DB
+		ranks    []string
+		rankArgs []any
+		total    int64
+		res      types.PaginatorResponse
 	)
 
-	countTotal := r.Database.Model(&models.User{}).Preload("Emails")
+	for _, filter := range req.Filters {
+		var value any = filter.Value
+
+		if filterBy[filter.Field] == "" {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": "Invalid Filter",
+			}).Error("filter field is not allowed")
+
+			return res, ErrInvalidFilter
+		}
+
+		if types.UserFilterRules[filter.Field].Datetime {
+			datetime, err := time.ParseInLocation("2006-01-02 15:04:05", filter.Value, r.TimeLocation)
+
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "05",
+					"error": err.Error(),
+				}).Error("failed to parse datetime for filter")
+
+				return res, ErrInvalidFilter
+			}
 
-	queryBuilder := r.Database.Model(&models.User{}).Preload("Emails")
+			value = datetime
+		}
+
+		scope := filterScope(filterBy[filter.Field], filter.Operator, value)
+
+		if strings.HasPrefix(filter.Field, "emails.") {
+			scope = associationScope(r.Database, "user_id", &models.Email{}, scope)
+		}
+
+		scopes = append(scopes, scope)
+	}
+
+	dialect := r.Database.Dialector.Name()
+
+	query := ""
+
+	if r.FullTextSearch && fullTextSupported(dialect) {
+		query = fullTextQuery(dialect, req.Search)
+	}
+
+	if req.Search != "" {
+		var searches []func(*gorm.DB) *gorm.DB
+
+		searchFields := req.SearchFields
+
+		if len(searchFields) == 0 {
+			searchFields = types.UserSearchFields
+		}
+
+		for _, field := range searchFields {
+			if searchBy[field] == "" {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "06",
+					"error": "Invalid Search Field",
+				}).Error("search field is not allowed")
+
+				return res, ErrInvalidSearchField
+			}
+
+			search := filterScope(searchBy[field], types.FilterLike, req.Search)
+
+			if query != "" {
+				table, column := "users", searchBy[field]
+
+				if strings.HasPrefix(field, "emails.") {
+					table = "emails"
+				}
+
+				search = func(db *gorm.DB) *gorm.DB {
+					return db.Where(fullTextMatch(dialect, table, column), query)
+				}
+
+				if table == "emails" {
+					ranks = append(ranks, "coalesce((select max("+fullTextRank(dialect, table, column)+") from emails where emails.user_id = users.id and emails.deleted_at is null), 0)")
+				} else {
+					ranks = append(ranks, fullTextRank(dialect, table, column))
+				}
+
+				rankArgs = append(rankArgs, query)
+			}
+
+			if strings.HasPrefix(field, "emails.") {
+				search = associationScope(r.Database, "user_id", &models.Email{}, search)
+			}
+
+			searches = append(searches, search)
+		}
+
+		scopes = append(scopes, orScope(r.Database, searches...))
+	}
+
+	countTotal := r.Database.Model(&models.User{}).Scopes(scopes...)
+
+	queryBuilder := r.Database.Model(&models.User{}).Scopes(scopes...)
+
+	sparse := len(req.Fields) > 0 || len(req.Include) > 0
+
+	if !sparse || slices.Contains(req.Include, "emails") {
+		queryBuilder.Preload("Emails")
+	}
 
 	if req.ID != "" {
 		countTotal.Where("id = ?", req.ID)
@@ -186,255 +456,602 @@ func (r *UserRepository) Read(ctx context.Context, req ReadUserData) (types.Pagi
 		queryBuilder.Where("id = ?", req.ID)
 	}
 
+	sorts, page := req.Sort, req.Page
+
+	if len(sorts) == 0 {
+		orderKey := req.OrderBy
+
+		if orderBy[orderKey] == "" {
+			orderKey = "name"
+		}
+
+		sorts = []types.SortField{{Field: orderKey, Desc: req.SortBy == "desc"}}
+	}
+
+	for _, sort := range sorts {
+		if orderBy[sort.Field] == "" {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "08",
+				"error": "Invalid Sort",
+			}).Error("sort field is not allowed")
+
+			return res, ErrInvalidSort
+		}
+	}
+
+	keys, orders, directions := orderColumns(orderBy, sorts, "id", req.Before != nil)
+
+	cursor := req.After
+
+	if req.Before != nil {
+		cursor = req.Before
+	}
+
+	if req.Cursor {
+		page = 1
+	}
+
+	columns := []string{"users.*"}
+
+	if len(req.Fields) > 0 {
+		columns = []string{"users." + selectBy["id"]}
+
+		for _, field := range req.Fields {
+			if selectBy[field] == "" {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "07",
+					"error": "Invalid Field",
+				}).Error("field is not allowed")
+
+				return res, ErrInvalidField
+			}
+
+			if field != "id" {
+				columns = append(columns, "users."+selectBy[field])
+			}
+		}
+
+		for _, key := range keys {
+			if req.Cursor && key != "id" && !slices.Contains(req.Fields, key) {
+				columns = append(columns, "users."+selectBy[key])
+			}
+		}
+	}
+
+	if len(ranks) > 0 && !req.Cursor && req.OrderBy == "" && len(req.Sort) == 0 {
+		columns = append(columns, "("+strings.Join(ranks, " + ")+") as search_rank")
+
+		queryBuilder.Order("search_rank desc")
+	} else {
+		rankArgs = nil
+	}
+
+	queryBuilder.Select(strings.Join(columns, ", "), rankArgs...)
+
 	gopackage.DataTable(
 		ctx,
 		queryBuilder,
-		search,
-		orderBy[req.OrderBy],
-		sortBy[req.SortBy],
+		nil,
+		orders[0],
+		directions[0],
 		orderBy["name"],
 		sortBy["asc"],
-		req.Page,
+		page,
 		&req.Limit,
-		req.Search,
+		"",
 		false,
 	)
 
-	queryBuilder.Find(&users)
+	for i := 1; i < len(orders); i++ {
+		queryBuilder.Order(orders[i] + " " + directions[i])
+	}
+
+	if req.Cursor && cursor != nil {
+		if !slices.Equal(cursor.Keys, keys) || len(cursor.Values) != len(keys) {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": "Invalid Cursor",
+			}).Error("cursor does not match the current order")
+
+			return res, ErrInvalidCursor
+		}
+
+		values := make([]any, len(keys))
+
+		for i, key := range keys {
+			values[i] = cursor.Values[i]
+
+			if key == "createdAt" || key == "updatedAt" {
+				value, err := time.Parse(time.RFC3339Nano, cast.ToString(cursor.Values[i]))
+
+				if err != nil {
+					logrus.WithFields(logrus.Fields{
+						"tag":   tag + "02",
+						"error": err.Error(),
+					}).Error("failed to parse cursor value")
+
+					return res, ErrInvalidCursor
+				}
+
+				values[i] = value
+			}
+		}
+
+		condition, args := keysetCondition(orders, directions, values)
+
+		queryBuilder.Where(condition, args...)
+	}
+
+	readUser := queryBuilder.Limit(req.Limit + 1).Find(&users)
+
+	if readUser.Error != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": readUser.Error.Error(),
+		}).Error("failed to read user data")
+
+		return res, readUser.Error
+	}
+
+	hasMore := len(users) > req.Limit
+
+	if hasMore {
+		users = users[:req.Limit]
+	}
+
+	res.NextPage = hasMore
+
+	if req.Cursor {
+		if req.Before != nil {
+			slices.Reverse(users)
+		}
+
+		if len(users) > 0 && (hasMore || req.Before != nil) {
+			res.NextCursor = types.EncodeCursor(userCursor(users[len(users)-1], keys))
+		}
+
+		if len(users) > 0 && (req.After != nil || (req.Before != nil && hasMore)) {
+			res.PrevCursor = types.EncodeCursor(userCursor(users[0], keys))
+		}
+
+		res.NextPage = res.NextCursor != ""
+	}
 
 	res.Records = users
 
+	if sparse {
+		records := make([]map[string]any, len(users))
+
+		for i, user := range users {
+			records[i] = userRecord(user, req.Fields, req.Include)
+		}
+
+		res.Records = records
+	}
+
 	if !req.DisableCalculateTotal {
 		countTotal.Count(&total)
 
 		res.Total = total
 	}
 
-	if len(users) >= req.Limit {
-		res.NextPage = true
+	return res, nil
+}
+
+func userRecord(user models.User, fields []string, include []string) map[string]any {
+	record := map[string]any{}
+
+	if len(fields) == 0 {
+		fields = types.UserFields
 	}
 
-	return res, nil
+	for _, field := range fields {
+		switch field {
+		case "id":
+			record["id"] = user.ID
+		case "name":
+			record["name"] = user.Name
+		case "createdAt":
+			record["createdAt"] = user.CreatedAt
+		case "updatedAt":
+			record["updatedAt"] = user.UpdatedAt
+		case "version":
+			record["version"] = user.Version
+		}
+	}
+
+	if slices.Contains(include, "emails") {
+		record["emails"] = user.Emails
+	}
+
+	return record
+}
+
+func userCursor(user models.User, keys []string) types.Cursor {
+	cursor := types.Cursor{
+		Keys:   keys,
+		Values: make([]any, len(keys)),
+	}
+
+	for i, key := range keys {
+		switch key {
+		case "id":
+			cursor.Values[i] = user.ID
+		case "name":
+			cursor.Values[i] = user.Name
+		case "createdAt":
+			cursor.Values[i] = user.CreatedAt.Format(time.RFC3339Nano)
+		case "updatedAt":
+			cursor.Values[i] = user.UpdatedAt.Format(time.RFC3339Nano)
+		}
+	}
+
+	return cursor
+}
+
+func (r *UserRepository) Export(ctx context.Context, req ReadUserData, fn func([]models.User) error) error {
+	var tag string = "internal.repositories.user.Export."
+
+	req.Page = 0
+	req.Limit = ExportChunkSize
+	req.DisableCalculateTotal = true
+	req.Cursor = true
+	req.After = nil
+	req.Before = nil
+	req.Fields = nil
+	req.Include = nil
+
+	for {
+		data, err := r.Read(ctx, req)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": err.Error(),
+			}).Error("failed to read user chunk")
+
+			return err
+		}
+
+		if err := fn(data.Records.([]models.User)); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": err.Error(),
+			}).Error("failed to write user chunk")
+
+			return err
+		}
+
+		if data.NextCursor == "" {
+			return nil
+		}
+
+		req.After, err = types.DecodeCursor(data.NextCursor)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": err.Error(),
+			}).Error("failed to decode next cursor")
+
+			return err
+		}
+	}
 }
 
-func (r *UserRepository) Update(req UpdateUserData) error {
+func (r *UserRepository) Find(ctx context.Context, id string) (models.User, error) {
 	var (
-		tag  string = "internal.repositories.user.Update."
+		tag  string = "internal.repositories.user.Find."
 		user models.User
 	)
 
-	tx := r.Database.Begin()
-
-	readUser := tx.First(&user, "id = ?", req.ID)
+	readUser := r.Database.WithContext(ctx).Preload("Emails").Limit(1).Find(&user, "id = ?", id)
 
-	if readUser.RowsAffected == 0 {
+	if readUser.Error != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "01",
-			"error": "Failed to Read User Data",
+			"error": readUser.Error.Error(),
 		}).Error("failed to read user data")
 
-		tx.Rollback()
-
-		return errors.New("FAILED_TO_READ_USER_DATA")
+		return user, readUser.Error
 	}
 
-	if req.Name != "" {
-		user.Name = req.Name
+	if readUser.RowsAffected == 0 {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": "User Not Found",
+		}).Error("failed to read user data")
+
+		return user, ErrUserNotFound
 	}
 
-	if len(req.Emails) > 0 {
-		deleteEmail := tx.Where("user_id = ?", user.ID).Delete(&models.Email{})
+	return user, nil
+}
 
-		if deleteEmail.Error != nil {
+func (r *UserRepository) Update(req UpdateUserData) (models.User, []models.Email, error) {
+	var (
+		tag   string = "internal.repositories.user.Update."
+		user  models.User
+		added []models.Email
+	)
+
+	err := r.Database.Transaction(func(tx *gorm.DB) error {
+		added = nil
+
+		readUser := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, "id = ?", req.ID)
+
+		if readUser.RowsAffected == 0 {
 			logrus.WithFields(logrus.Fields{
-				"tag":   tag + "02",
-				"error": deleteEmail.Error.Error(),
-			}).Error("failed to delete email data")
+				"tag":   tag + "01",
+				"error": "Failed to Read User Data",
+			}).Error("failed to read user data")
 
-			tx.Rollback()
+			if readUser.Error != nil && !errors.Is(readUser.Error, gorm.ErrRecordNotFound) {
+				return readUser.Error
+			}
 
-			return deleteEmail.Error
+			return ErrUserNotFound
 		}
 
-		if deleteEmail.RowsAffected == 0 {
+		if req.Version != nil && *req.Version != user.Version {
 			logrus.WithFields(logrus.Fields{
-				"tag":   tag + "03",
-				"error": "Failed to Delete Email Data",
-			}).Error("failed to delete email data")
+				"tag":   tag + "02",
+				"error": "Version Mismatch",
+			}).Error("user data has been modified")
 
-			tx.Rollback()
+			return ErrVersionMismatch
+		}
 
-			return errors.New("FAILED_TO_DELETE_EMAIL_DATA")
+		if req.Name != "" {
+			user.Name = req.Name
 		}
 
-		for _, v := range req.Emails {
-			var email models.Email
+		var emails []models.Email
 
-			emailUUID, err := uuid.NewRandom()
+		readEmail := tx.Order("created_at asc").Find(&emails, "user_id = ?", user.ID)
 
-			if err != nil {
-				logrus.WithFields(logrus.Fields{
-					"tag":   tag + "04",
-					"error": err.Error(),
-				}).Error("failed to generate uuid")
+		if readEmail.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": readEmail.Error.Error(),
+			}).Error("failed to read email data")
 
-				tx.Rollback()
+			return ErrFailedToReadEmail
+		}
 
-				return err
+		user.UpdatedAt = time.Now().In(r.TimeLocation)
+
+		if len(req.Emails) == 0 {
+			user.Emails = emails
+		} else {
+			var (
+				current map[string]models.Email = map[string]models.Email{}
+				removed []string
+			)
+
+			for _, email := range emails {
+				current[strings.ToLower(email.Email)] = email
 			}
 
-			email.ID = emailUUID.String()
-			email.CreatedAt = time.Now().In(r.TimeLocation)
-			email.UpdatedAt = time.Now().In(r.TimeLocation)
-			email.UserID = user.ID
-			email.Email = v
+			for _, email := range emails {
+				if !slices.ContainsFunc(req.Emails, func(v string) bool { return strings.EqualFold(v, email.Email) }) {
+					removed = append(removed, email.ID)
+				}
+			}
 
-			createEmail := tx.Save(&email)
+			if len(removed) > 0 {
+				deleteEmail := tx.Where("user_id = ? AND id IN ?", user.ID, removed).Delete(&models.Email{})
 
-			if createEmail.Error != nil {
-				logrus.WithFields(logrus.Fields{
-					"tag":   tag + "05",
-					"error": createEmail.Error.Error(),
-				}).Error("failed to create email")
+				if deleteEmail.Error != nil {
+					logrus.WithFields(logrus.Fields{
+						"tag":   tag + "04",
+						"error": deleteEmail.Error.Error(),
+					}).Error("failed to delete email data")
 
-				tx.Rollback()
+					return deleteEmail.Error
+				}
 
-				return createEmail.Error
+				if deleteEmail.RowsAffected == 0 {
+					logrus.WithFields(logrus.Fields{
+						"tag":   tag + "05",
+						"error": "Failed to Delete Email Data",
+					}).Error("failed to delete email data")
+
+					return ErrFailedToDeleteEmail
+				}
 			}
 
-			if createEmail.RowsAffected == 0 {
-				logrus.WithFields(logrus.Fields{
-					"tag":   tag + "06",
-					"error": "Failed to Create Email",
-				}).Error("failed to create email")
+			for i, v := range req.Emails {
+				email, ok := current[strings.ToLower(v)]
 
-				tx.Rollback()
+				if ok {
+					if email.Primary != (i == 0) {
+						email.Primary = i == 0
+						email.UpdatedAt = user.UpdatedAt
 
-				return errors.New("FAILED_TO_CREATE_EMAIL")
-			}
+						updateEmail := tx.Model(&email).Updates(map[string]any{
+							"is_primary": email.Primary,
+							"updated_at": email.UpdatedAt,
+						})
 
-			user.Emails = append(user.Emails, email)
-		}
-	} else {
-		var emails []models.Email
+						if updateEmail.Error != nil {
+							logrus.WithFields(logrus.Fields{
+								"tag":   tag + "06",
+								"error": updateEmail.Error.Error(),
+							}).Error("failed to update email data")
 
-		readEmail := tx.Find(&emails, "user_id = ?", user.ID)
+							return updateEmail.Error
+						}
+					}
 
-		if readEmail.RowsAffected == 0 {
-			logrus.WithFields(logrus.Fields{
-				"tag":   tag + "07",
-				"error": "Failed to Read Email Data",
-			}).Error("failed to read email data")
+					user.Emails = append(user.Emails, email)
 
-			tx.Rollback()
+					continue
+				}
 
-			return errors.New("FAILED_TO_READ_EMAIL_DATA")
-		}
+				emailUUID, err := uuid.NewRandom()
 
-		user.Emails = emails
-	}
+				if err != nil {
+					logrus.WithFields(logrus.Fields{
+						"tag":   tag + "07",
+						"error": err.Error(),
+					}).Error("failed to generate uuid")
 
-	user.UpdatedAt = time.Now().In(r.TimeLocation)
+					return err
+				}
 
-	updateUser := tx.Save(&user)
+				email = models.Email{
+					ID:        emailUUID.String(),
+					CreatedAt: user.UpdatedAt,
+					UpdatedAt: user.UpdatedAt,
+					UserID:    user.ID,
+					Email:     v,
+					Primary:   i == 0,
+				}
 
-	if updateUser.Error != nil {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "08",
-			"error": updateUser.Error.Error(),
-		}).Error("failed to update user data")
+				createEmail := tx.Create(&email)
 
-		tx.Rollback()
+				if createEmail.Error != nil {
+					logrus.WithFields(logrus.Fields{
+						"tag":   tag + "08",
+						"error": createEmail.Error.Error(),
+					}).Error("failed to create email")
 
-		return updateUser.Error
-	}
+					return createEmail.Error
+				}
 
-	if updateUser.RowsAffected == 0 {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "09",
-			"error": "Failed to Update User Data",
-		}).Error("failed to update user data")
+				if createEmail.RowsAffected == 0 {
+					logrus.WithFields(logrus.Fields{
+						"tag":   tag + "09",
+						"error": "Failed to Create Email",
+					}).Error("failed to create email")
 
-		tx.Rollback()
+					return ErrFailedToCreateEmail
+				}
 
-		return errors.New("FAILED_TO_UPDATE_USER_DATA")
-	}
+				user.Emails = append(user.Emails, email)
 
-	tx.Commit()
+				added = append(added, email)
+			}
+		}
+
+		updateUser := tx.Model(&user).Updates(map[string]any{
+			"name":       user.Name,
+			"updated_at": user.UpdatedAt,
+			"version":    gorm.Expr("version + 1"),
+		})
+
+		if updateUser.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "10",
+				"error": updateUser.Error.Error(),
+			}).Error("failed to update user data")
+
+			return updateUser.Error
+		}
 
-	return nil
+		if updateUser.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "11",
+				"error": "Failed to Update User Data",
+			}).Error("failed to update user data")
+
+			return ErrFailedToUpdateUser
+		}
+
+		user.Version++
+
+		return nil
+	})
+
+	if err != nil {
+		return user, nil, emailConflict(r.Database, req.ID, req.Emails, err)
+	}
+
+	return user, added, nil
 }
 
-func (r *UserRepository) Delete(id string) error {
+func (r *UserRepository) Delete(id string, version *int64) error {
 	var (
 		tag  string = "internal.repositories.user.Delete."
 		user models.User
 	)
 
-	tx := r.Database.Begin()
-
-	readUser := tx.First(&user, "id = ?", id)
-
-	if readUser.RowsAffected == 0 {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "01",
-			"error": "Failed To Read User Data",
-		}).Error("failed to read user data")
-
-		tx.Rollback()
+	return r.Database.Transaction(func(tx *gorm.DB) error {
+		readUser := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, "id = ?", id)
 
-		return errors.New("FAILED_TO_READ_USER_DATA")
-	}
+		if readUser.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"error": "Failed To Read User Data",
+			}).Error("failed to read user data")
 
-	deleteUser := tx.Delete(&user, "id = ?", id)
+			if readUser.Error != nil && !errors.Is(readUser.Error, gorm.ErrRecordNotFound) {
+				return readUser.Error
+			}
 
-	if deleteUser.Error != nil {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "02",
-			"error": deleteUser.Error.Error(),
-		}).Error("failed to delete user data")
+			return ErrUserNotFound
+		}
 
-		tx.Rollback()
+		if version != nil && *version != user.Version {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": "Version Mismatch",
+			}).Error("user data has been modified")
 
-		return deleteUser.Error
-	}
+			return ErrVersionMismatch
+		}
 
-	if deleteUser.RowsAffected == 0 {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "03",
-			"error": "Failed To Delete User Data",
-		}).Error("failed to delete user data")
+		deleteUser := tx.Delete(&user, "id = ?", id)
 
-		tx.Rollback()
+		if deleteUser.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": deleteUser.Error.Error(),
+			}).Error("failed to delete user data")
 
-		return errors.New("FAILED_TO_DELETE_USER_DATA")
-	}
+			return deleteUser.Error
+		}
 
-	deleteEmail := tx.Where("user_id = ?", id).Delete(&models.Email{})
+		if deleteUser.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": "Failed To Delete User Data",
+			}).Error("failed to delete user data")
 
-	if deleteEmail.Error != nil {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "04",
-			"error": deleteEmail.Error.Error(),
-		}).Error("failed to delete email data")
+			return ErrFailedToDeleteUser
+		}
 
-		tx.Rollback()
+		deleteEmail := tx.Where("user_id = ?", id).Delete(&models.Email{})
 
-		return deleteEmail.Error
-	}
+		if deleteEmail.Error != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "05",
+				"error": deleteEmail.Error.Error(),
+			}).Error("failed to delete email data")
 
-	if deleteEmail.RowsAffected == 0 {
-		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "05",
-			"error": "Failed To Delete Email Data",
-		}).Error("failed to delete email data")
+			return deleteEmail.Error
+		}
 
-		tx.Rollback()
+		if deleteEmail.RowsAffected == 0 {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "06",
+				"error": "Failed To Delete Email Data",
+			}).Error("failed to delete email data")
 
-		return errors.New("FAILED_TO_DELETE_EMAIL_DATA")
-	}
+			return ErrFailedToDeleteEmail
+		}
 
-	tx.Commit()
+		return nil
+	})
+}
 
-	return nil
+func (r *UserRepository) Transaction(ctx context.Context, fn func(IUserRepository) error) error {
+	return r.Database.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&UserRepository{
+			TimeLocation:   r.TimeLocation,
+			Database:       tx,
+			FullTextSearch: r.FullTextSearch,
+		})
+	})
 }
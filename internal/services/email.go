@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MrAndreID/goapi/databases/models"
+	"github.com/MrAndreID/goapi/emails"
+	"github.com/MrAndreID/goapi/internal/repositories"
+	"github.com/MrAndreID/goapi/internal/types"
+	"github.com/MrAndreID/goapi/notifiers"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrInvalidVerificationToken  = types.NewValidationError("INVALID_VERIFICATION_TOKEN")
+	ErrVerificationTokenExpired  = types.NewValidationError("VERIFICATION_TOKEN_EXPIRED")
+	ErrEmailAlreadyVerified      = types.NewConflictError("EMAIL_ALREADY_VERIFIED")
+	ErrFailedToSendVerification  = types.NewInternalError("FAILED_TO_SEND_VERIFICATION")
+	ErrVerificationNotConfigured = types.NewInternalError("EMAIL_VERIFICATION_NOT_CONFIGURED")
+)
+
+type IEmailService interface {
+	Read(context.Context, types.ReadEmailRequest) ([]models.Email, error)
+	Create(context.Context, types.CreateEmailRequest) (models.Email, error)
+	Verify(context.Context, types.VerifyEmailRequest) (models.Email, error)
+	ResendVerification(context.Context, types.ResendEmailVerificationRequest) error
+	Delete(context.Context, types.DeleteEmailRequest) error
+}
+
+type EmailServiceOptions struct {
+	EmailPolicy     *emails.Policy
+	Notifier        notifiers.INotifier
+	AppKey          string
+	VerificationTTL time.Duration
+}
+
+type EmailService struct {
+	EmailRepository repositories.IEmailRepository
+	Options         EmailServiceOptions
+}
+
+func NewEmailService(emailRepository repositories.IEmailRepository, options EmailServiceOptions) *EmailService {
+	return &EmailService{
+		EmailRepository: emailRepository,
+		Options:         options,
+	}
+}
+
+func (s *EmailService) Read(ctx context.Context, req types.ReadEmailRequest) ([]models.Email, error) {
+	var tag string = "internal.services.email.Read."
+
+	userEmails, err := s.EmailRepository.Read(ctx, req.UserID)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to read email (from email repository)")
+
+		return userEmails, err
+	}
+
+	return userEmails, nil
+}
+
+func (s *EmailService) Create(ctx context.Context, req types.CreateEmailRequest) (models.Email, error) {
+	var (
+		tag   string = "internal.services.email.Create."
+		email models.Email
+	)
+
+	addresses, errs := normalizeEmails(s.Options.EmailPolicy, []string{req.Email})
+
+	if errs != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": errs,
+		}).Error("invalid email")
+
+		return email, ErrInvalidEmail.WithDetails(map[string]string{"email": errs["emails"]["0"]})
+	}
+
+	email, err := s.EmailRepository.Create(ctx, repositories.CreateEmailData{
+		UserID:  req.UserID,
+		Email:   addresses[0],
+		Primary: req.Primary,
+	})
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to create email (from email repository)")
+
+		return email, err
+	}
+
+	if err := s.sendVerification(ctx, email); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Warn("failed to send email verification, it can be resent later")
+	}
+
+	return email, nil
+}
+
+func (s *EmailService) Verify(ctx context.Context, req types.VerifyEmailRequest) (models.Email, error) {
+	var (
+		tag   string = "internal.services.email.Verify."
+		email models.Email
+	)
+
+	token, err := emails.ParseToken(s.Options.AppKey, req.Token, time.Now())
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to parse verification token")
+
+		switch {
+		case errors.Is(err, emails.ErrMissingKey):
+			return email, ErrVerificationNotConfigured
+		case errors.Is(err, emails.ErrExpiredToken):
+			return email, ErrVerificationTokenExpired
+		}
+
+		return email, ErrInvalidVerificationToken
+	}
+
+	if token.EmailID != req.EmailID {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": "Token Email ID Mismatch",
+		}).Error("verification token belongs to another email")
+
+		return email, ErrInvalidVerificationToken
+	}
+
+	email, err = s.EmailRepository.Find(ctx, req.UserID, req.EmailID)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to find email (from email repository)")
+
+		return email, err
+	}
+
+	if token.Email != email.Email {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "04",
+			"error": "Token Email Mismatch",
+		}).Error("verification token was issued for another address")
+
+		return email, ErrInvalidVerificationToken
+	}
+
+	email, err = s.EmailRepository.Verify(ctx, req.UserID, req.EmailID)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "05",
+			"error": err.Error(),
+		}).Error("failed to verify email (from email repository)")
+
+		return email, err
+	}
+
+	return email, nil
+}
+
+func (s *EmailService) ResendVerification(ctx context.Context, req types.ResendEmailVerificationRequest) error {
+	var tag string = "internal.services.email.ResendVerification."
+
+	email, err := s.EmailRepository.Find(ctx, req.UserID, req.EmailID)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to find email (from email repository)")
+
+		return err
+	}
+
+	if email.VerifiedAt != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": "Email Already Verified",
+		}).Error("email already verified")
+
+		return ErrEmailAlreadyVerified
+	}
+
+	if err := s.sendVerification(ctx, email); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to send email verification")
+
+		if errors.Is(err, emails.ErrMissingKey) {
+			return ErrVerificationNotConfigured
+		}
+
+		return ErrFailedToSendVerification
+	}
+
+	return nil
+}
+
+func (s *EmailService) Delete(ctx context.Context, req types.DeleteEmailRequest) error {
+	var tag string = "internal.services.email.Delete."
+
+	if err := s.EmailRepository.Delete(ctx, req.UserID, req.EmailID); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to delete email (from email repository)")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *EmailService) sendVerification(ctx context.Context, email models.Email) error {
+	return sendVerification(ctx, s.Options.Notifier, s.Options.AppKey, s.Options.VerificationTTL, email)
+}
+
+func sendVerification(ctx context.Context, notifier notifiers.INotifier, appKey string, ttl time.Duration, email models.Email) error {
+	expiresAt := time.Now().Add(ttl)
+
+	token, err := emails.SignToken(appKey, emails.Token{
+		EmailID:   email.ID,
+		Email:     email.Email,
+		ExpiresAt: expiresAt.Unix(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if notifier == nil {
+		return nil
+	}
+
+	return notifier.Send(ctx, notifiers.Message{
+		To:      email.Email,
+		Subject: "Verify Your Email Address",
+		Body:    "Use the token below to verify your email address. It expires at " + expiresAt.UTC().Format(time.RFC3339) + ".\n\n" + token,
+	})
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MrAndreID/goapi/internal/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	HealthStatusUp       string = "UP"
+	HealthStatusDown     string = "DOWN"
+	HealthStatusDegraded string = "DEGRADED"
+)
+
+type IHealthService interface {
+	Live() types.HealthResponse
+	Ready(context.Context) types.HealthResponse
+}
+
+type HealthCheck struct {
+	Name      string
+	Optional  bool
+	Connected bool
+	Ping      func(context.Context) error
+}
+
+type HealthService struct {
+	Checks  []HealthCheck
+	Timeout time.Duration
+}
+
+func NewHealthService(checks []HealthCheck, timeout time.Duration) *HealthService {
+	return &HealthService{
+		Checks:  checks,
+		Timeout: timeout,
+	}
+}
+
+func (s *HealthService) Live() types.HealthResponse {
+	res := types.HealthResponse{
+		Status: HealthStatusUp,
+		Checks: make(map[string]types.HealthCheckResponse, len(s.Checks)),
+	}
+
+	for _, check := range s.Checks {
+		status := HealthStatusUp
+
+		if !check.Connected {
+			status = HealthStatusDown
+		}
+
+		res.Checks[check.Name] = types.HealthCheckResponse{
+			Status: status,
+		}
+	}
+
+	return res
+}
+
+func (s *HealthService) Ready(ctx context.Context) types.HealthResponse {
+	var (
+		tag   string = "internal.services.health.Ready."
+		res   types.HealthResponse
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	res.Status = HealthStatusUp
+	res.Checks = make(map[string]types.HealthCheckResponse, len(s.Checks))
+
+	for _, check := range s.Checks {
+		wg.Add(1)
+
+		go func(check HealthCheck) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+
+			defer cancel()
+
+			start := time.Now()
+
+			err := check.Ping(checkCtx)
+
+			checkRes := types.HealthCheckResponse{
+				Status:  HealthStatusUp,
+				Latency: time.Since(start).String(),
+			}
+
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "01",
+					"name":  check.Name,
+					"error": err.Error(),
+				}).Error("failed to check dependency")
+
+				checkRes.Status = HealthStatusDown
+				checkRes.Error = err.Error()
+			}
+
+			mutex.Lock()
+
+			defer mutex.Unlock()
+
+			res.Checks[check.Name] = checkRes
+
+			if err != nil && !check.Optional {
+				res.Status = HealthStatusDown
+			} else if err != nil && res.Status == HealthStatusUp {
+				res.Status = HealthStatusDegraded
+			}
+		}(check)
+	}
+
+	wg.Wait()
+
+	return res
+}
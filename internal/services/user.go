@@ -2,60 +2,114 @@ package services
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
+	"io"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MrAndreID/goapi/databases/models"
+	"github.com/MrAndreID/goapi/emails"
 	"github.com/MrAndreID/goapi/internal/repositories"
 	"github.com/MrAndreID/goapi/internal/types"
+	"github.com/MrAndreID/goapi/notifiers"
 
 	"github.com/sirupsen/logrus"
 )
 
+const ImportBatchSize int = 500
+
+var (
+	ErrDuplicateEmail      = types.NewValidationError("DUPLICATE_EMAIL")
+	ErrInvalidEmail        = types.NewValidationError("INVALID_EMAIL")
+	ErrInvalidImportFile   = types.NewValidationError("INVALID_IMPORT_FILE")
+	ErrFailedToImportBatch = types.NewInternalError("FAILED_TO_IMPORT_BATCH")
+	ErrBulkLimitExceeded   = types.NewValidationError("BULK_LIMIT_EXCEEDED")
+	ErrInvalidBulkItem     = types.NewValidationError("INVALID_BULK_ITEM")
+	ErrBulkItemFailed      = types.NewInternalError("BULK_ITEM_FAILED")
+)
+
 type IUserService interface {
 	Create(types.CreateUserRequest) (models.User, error)
 	Read(context.Context, types.ReadUserRequest) (types.PaginatorResponse, error)
-	Update(types.UpdateUserRequest) error
-	Delete(string) error
+	Export(context.Context, types.ExportUserRequest, func([]models.User) error) error
+	Import(context.Context, io.Reader, bool) (types.ImportUserResponse, error)
+	Find(context.Context, string) (models.User, error)
+	Update(types.UpdateUserRequest) (models.User, error)
+	Delete(types.DeleteUserRequest) error
+	BulkCreate(context.Context, types.BulkCreateUserRequest) (types.BulkUserResponse, error)
+	BulkUpdate(context.Context, types.BulkUpdateUserRequest) (types.BulkUserResponse, error)
+	BulkDelete(context.Context, types.BulkDeleteUserRequest) (types.BulkUserResponse, error)
+}
+
+type UserServiceOptions struct {
+	BulkMaxItems    int
+	EmailPolicy     *emails.Policy
+	Notifier        notifiers.INotifier
+	AppKey          string
+	VerificationTTL time.Duration
 }
 
 type UserService struct {
 	UserRepository repositories.IUserRepository
+	Options        UserServiceOptions
 }
 
-func NewUserService(userRepository repositories.IUserRepository) *UserService {
+func NewUserService(userRepository repositories.IUserRepository, options UserServiceOptions) *UserService {
 	return &UserService{
 		UserRepository: userRepository,
+		Options:        options,
 	}
 }
 
 func (s *UserService) Create(req types.CreateUserRequest) (models.User, error) {
+	user, err := s.create(req)
+
+	if err != nil {
+		return user, err
+	}
+
+	s.sendVerifications(context.Background(), user.Emails)
+
+	return user, nil
+}
+
+func (s *UserService) create(req types.CreateUserRequest) (models.User, error) {
 	var (
-		tag  string = "internal.services.user.Create."
+		tag  string = "internal.services.user.create."
 		user models.User
 	)
 
-	for i := 0; i < len(req.Emails); i++ {
-		for j := i + 1; j < len(req.Emails); j++ {
-			if req.Emails[i] == req.Emails[j] {
-				logrus.WithFields(logrus.Fields{
-					"tag":   tag + "01",
-					"error": "Duplicate Email",
-				}).Error("duplicate email")
+	addresses, errs := normalizeEmails(s.Options.EmailPolicy, req.Emails)
 
-				return user, errors.New("DUPLICATE_EMAIL")
-			}
-		}
+	if errs != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": errs,
+		}).Error("invalid email")
+
+		return user, ErrInvalidEmail.WithDetails(errs)
+	}
+
+	if hasDuplicateEmail(addresses) {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": "Duplicate Email",
+		}).Error("duplicate email")
+
+		return user, ErrDuplicateEmail
 	}
 
 	user, err := s.UserRepository.Create(repositories.CreateUserData{
 		Name:   req.Name,
-		Emails: req.Emails,
+		Emails: addresses,
 	})
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
-			"tag":   tag + "02",
+			"tag":   tag + "03",
 			"error": err.Error(),
 		}).Error("failed to create user (from user repository)")
 
@@ -72,6 +126,8 @@ func (s *UserService) Read(ctx context.Context, req types.ReadUserRequest) (type
 		err                   error
 		page, limit           int
 		disableCalculateTotal bool
+		cursor                bool
+		after, before         *types.Cursor
 	)
 
 	if req.Page != "" {
@@ -113,13 +169,60 @@ func (s *UserService) Read(ctx context.Context, req types.ReadUserRequest) (type
 		}
 	}
 
+	if req.Cursor != "" {
+		cursor, err = strconv.ParseBool(req.Cursor)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "05",
+				"error": err.Error(),
+			}).Error("failed to convert from string to bool for cursor from request")
+
+			return res, err
+		}
+	}
+
+	if req.After != "" {
+		after, err = types.DecodeCursor(req.After)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "06",
+				"error": err.Error(),
+			}).Error("failed to decode after cursor from request")
+
+			return res, repositories.ErrInvalidCursor
+		}
+	}
+
+	if req.Before != "" {
+		before, err = types.DecodeCursor(req.Before)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "07",
+				"error": err.Error(),
+			}).Error("failed to decode before cursor from request")
+
+			return res, repositories.ErrInvalidCursor
+		}
+	}
+
 	data, err := s.UserRepository.Read(ctx, repositories.ReadUserData{
 		Page:                  page,
 		Limit:                 limit,
 		OrderBy:               req.OrderBy,
 		SortBy:                req.SortBy,
 		Search:                req.Search,
+		SearchFields:          types.SplitList(req.SearchFields),
 		DisableCalculateTotal: disableCalculateTotal,
+		Cursor:                cursor || after != nil || before != nil,
+		After:                 after,
+		Before:                before,
+		Filters:               req.Filter,
+		Fields:                types.SplitList(req.Fields),
+		Include:               types.SplitList(req.Include),
+		Sort:                  types.ParseSort(req.Sort),
 		ID:                    req.ID,
 	})
 
@@ -135,46 +238,321 @@ func (s *UserService) Read(ctx context.Context, req types.ReadUserRequest) (type
 	return data, nil
 }
 
-func (s *UserService) Update(req types.UpdateUserRequest) error {
-	var tag string = "internal.services.user.Update."
+func (s *UserService) Export(ctx context.Context, req types.ExportUserRequest, fn func([]models.User) error) error {
+	var tag string = "internal.services.user.Export."
+
+	err := s.UserRepository.Export(ctx, repositories.ReadUserData{
+		OrderBy:      req.OrderBy,
+		SortBy:       req.SortBy,
+		Sort:         types.ParseSort(req.Sort),
+		Search:       req.Search,
+		SearchFields: types.SplitList(req.SearchFields),
+		Filters:      req.Filter,
+		ID:           req.ID,
+	}, fn)
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to export user (from user repository)")
 
-	if len(req.Emails) > 0 {
-		for i := 0; i < len(req.Emails); i++ {
-			for j := i + 1; j < len(req.Emails); j++ {
-				if req.Emails[i] == req.Emails[j] {
-					logrus.WithFields(logrus.Fields{
-						"tag":   tag + "01",
-						"error": "Duplicate Email",
-					}).Error("duplicate email")
+		return err
+	}
+
+	return nil
+}
+
+func (s *UserService) Import(ctx context.Context, file io.Reader, dryRun bool) (types.ImportUserResponse, error) {
+	var (
+		tag     string = "internal.services.user.Import."
+		res     types.ImportUserResponse
+		batch   []repositories.CreateUserData
+		rows    []int
+		columns map[string]int  = map[string]int{}
+		seen    map[string]bool = map[string]bool{}
+	)
+
+	res.DryRun = dryRun
+	res.Failed = []types.ImportUserRowError{}
+
+	reader := csv.NewReader(file)
+
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to read csv header")
+
+		return res, ErrInvalidImportFile
+	}
+
+	for i, column := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))] = i
+	}
 
-					return errors.New("DUPLICATE_EMAIL")
+	if _, ok := columns["name"]; !ok {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": "Missing Name Column",
+		}).Error("csv header has no name column")
+
+		return res, ErrInvalidImportFile
+	}
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		var addresses []string
+
+		for _, data := range batch {
+			addresses = append(addresses, data.Emails...)
+		}
+
+		taken, err := s.UserRepository.TakenEmails(ctx, addresses)
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": err.Error(),
+			}).Error("failed to read taken emails (from user repository)")
+
+			return err
+		}
+
+		accepted, acceptedRows := batch[:0], rows[:0]
+
+		for i, data := range batch {
+			if slices.ContainsFunc(data.Emails, func(address string) bool { return taken[address] }) {
+				res.Failed = append(res.Failed, types.ImportUserRowError{
+					Row:    rows[i],
+					Errors: map[string]string{"emails": repositories.ErrEmailAlreadyTaken.Code},
+				})
+
+				continue
+			}
+
+			accepted, acceptedRows = append(accepted, data), append(acceptedRows, rows[i])
+		}
+
+		res.Valid += len(accepted)
+
+		if !dryRun && len(accepted) > 0 {
+			created, err := s.UserRepository.CreateBatch(ctx, accepted)
+
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "04",
+					"error": err.Error(),
+				}).Error("failed to create user batch (from user repository)")
+
+				if ctx.Err() != nil {
+					return ctx.Err()
 				}
+
+				res.Valid -= len(accepted)
+
+				for _, row := range acceptedRows {
+					res.Failed = append(res.Failed, types.ImportUserRowError{
+						Row:    row,
+						Errors: map[string]string{"batch": ErrFailedToImportBatch.Code},
+					})
+				}
+
+				batch, rows = batch[:0], rows[:0]
+
+				return nil
 			}
+
+			res.Created += len(accepted)
+
+			s.sendVerifications(ctx, created)
 		}
+
+		batch, rows = batch[:0], rows[:0]
+
+		return nil
 	}
 
-	err := s.UserRepository.Update(repositories.UpdateUserData{
-		ID:     req.ID,
-		Name:   req.Name,
-		Emails: req.Emails,
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			var parseError *csv.ParseError
+
+			if !errors.As(err, &parseError) {
+				logrus.WithFields(logrus.Fields{
+					"tag":   tag + "05",
+					"error": err.Error(),
+				}).Error("failed to read csv row")
+
+				return res, err
+			}
+
+			res.Failed = append(res.Failed, types.ImportUserRowError{
+				Row:    row,
+				Errors: map[string]string{"row": parseError.Err.Error()},
+			})
+
+			continue
+		}
+
+		req := types.CreateUserRequest{
+			Name: importColumn(record, columns, "name"),
+		}
+
+		for _, email := range strings.Split(importColumn(record, columns, "emails"), ";") {
+			if email = strings.TrimSpace(email); email != "" {
+				req.Emails = append(req.Emails, email)
+			}
+		}
+
+		if errs := req.Validate(); errs != nil {
+			res.Failed = append(res.Failed, types.ImportUserRowError{
+				Row:    row,
+				Errors: errs,
+			})
+
+			continue
+		}
+
+		addresses, errs := normalizeEmails(s.Options.EmailPolicy, req.Emails)
+
+		if errs != nil {
+			res.Failed = append(res.Failed, types.ImportUserRowError{
+				Row:    row,
+				Errors: errs,
+			})
+
+			continue
+		}
+
+		if hasDuplicateEmail(addresses) || slices.ContainsFunc(addresses, func(address string) bool { return seen[address] }) {
+			res.Failed = append(res.Failed, types.ImportUserRowError{
+				Row:    row,
+				Errors: map[string]string{"emails": ErrDuplicateEmail.Code},
+			})
+
+			continue
+		}
+
+		for _, address := range addresses {
+			seen[address] = true
+		}
+
+		batch = append(batch, repositories.CreateUserData{
+			Name:   req.Name,
+			Emails: addresses,
+		})
+
+		rows = append(rows, row)
+
+		if len(batch) >= ImportBatchSize {
+			if err := flush(); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return res, err
+	}
+
+	slices.SortFunc(res.Failed, func(a, b types.ImportUserRowError) int {
+		return a.Row - b.Row
 	})
 
+	return res, nil
+}
+
+func (s *UserService) Find(ctx context.Context, id string) (models.User, error) {
+	var tag string = "internal.services.user.Find."
+
+	user, err := s.UserRepository.Find(ctx, id)
+
 	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to find user (from user repository)")
+
+		return user, err
+	}
+
+	return user, nil
+}
+
+func (s *UserService) Update(req types.UpdateUserRequest) (models.User, error) {
+	user, added, err := s.update(req)
+
+	if err != nil {
+		return user, err
+	}
+
+	s.sendVerifications(context.Background(), added)
+
+	return user, nil
+}
+
+func (s *UserService) update(req types.UpdateUserRequest) (models.User, []models.Email, error) {
+	var (
+		tag  string = "internal.services.user.update."
+		user models.User
+	)
+
+	addresses, errs := normalizeEmails(s.Options.EmailPolicy, req.Emails)
+
+	if errs != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": errs,
+		}).Error("invalid email")
+
+		return user, nil, ErrInvalidEmail.WithDetails(errs)
+	}
+
+	if hasDuplicateEmail(addresses) {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "02",
+			"error": "Duplicate Email",
+		}).Error("duplicate email")
+
+		return user, nil, ErrDuplicateEmail
+	}
+
+	user, added, err := s.UserRepository.Update(repositories.UpdateUserData{
+		ID:      req.ID,
+		Name:    req.Name,
+		Emails:  addresses,
+		Version: req.Version,
+	})
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
 			"error": err.Error(),
 		}).Error("failed to update user (from user repository)")
 
-		return err
+		return user, nil, err
 	}
 
-	return nil
+	return user, added, nil
 }
 
-func (s *UserService) Delete(id string) error {
+func (s *UserService) Delete(req types.DeleteUserRequest) error {
 	var tag string = "internal.services.user.Delete."
 
-	if err := s.UserRepository.Delete(id); err != nil {
+	if err := s.UserRepository.Delete(req.ID, req.Version); err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "01",
 			"error": err.Error(),
@@ -185,3 +563,230 @@ func (s *UserService) Delete(id string) error {
 
 	return nil
 }
+
+func (s *UserService) BulkCreate(ctx context.Context, req types.BulkCreateUserRequest) (types.BulkUserResponse, error) {
+	return s.bulk(ctx, req.Atomic == "true", len(req.Items), func(service *UserService, i int) (types.BulkUserItemResponse, []models.Email, error) {
+		if errs := req.Items[i].Validate(); errs != nil {
+			return types.BulkUserItemResponse{}, nil, ErrInvalidBulkItem.WithDetails(errs)
+		}
+
+		user, err := service.create(req.Items[i])
+
+		if err != nil {
+			return types.BulkUserItemResponse{}, nil, err
+		}
+
+		return types.BulkUserItemResponse{Status: "created", ID: user.ID}, user.Emails, nil
+	})
+}
+
+func (s *UserService) BulkUpdate(ctx context.Context, req types.BulkUpdateUserRequest) (types.BulkUserResponse, error) {
+	return s.bulk(ctx, req.Atomic == "true", len(req.Items), func(service *UserService, i int) (types.BulkUserItemResponse, []models.Email, error) {
+		if errs := req.Items[i].Validate(); errs != nil {
+			return types.BulkUserItemResponse{}, nil, ErrInvalidBulkItem.WithDetails(errs)
+		}
+
+		_, added, err := service.update(req.Items[i])
+
+		if err != nil {
+			return types.BulkUserItemResponse{}, nil, err
+		}
+
+		return types.BulkUserItemResponse{Status: "updated", ID: req.Items[i].ID}, added, nil
+	})
+}
+
+func (s *UserService) BulkDelete(ctx context.Context, req types.BulkDeleteUserRequest) (types.BulkUserResponse, error) {
+	return s.bulk(ctx, req.Atomic == "true", len(req.IDs), func(service *UserService, i int) (types.BulkUserItemResponse, []models.Email, error) {
+		item := types.DeleteUserRequest{ID: req.IDs[i]}
+
+		if version, ok := req.Versions[req.IDs[i]]; ok {
+			item.Version = &version
+		}
+
+		if errs := item.Validate(); errs != nil {
+			return types.BulkUserItemResponse{}, nil, ErrInvalidBulkItem.WithDetails(errs)
+		}
+
+		if err := service.Delete(item); err != nil {
+			return types.BulkUserItemResponse{}, nil, err
+		}
+
+		return types.BulkUserItemResponse{Status: "deleted", ID: req.IDs[i]}, nil, nil
+	})
+}
+
+func (s *UserService) bulk(ctx context.Context, atomic bool, size int, fn func(*UserService, int) (types.BulkUserItemResponse, []models.Email, error)) (types.BulkUserResponse, error) {
+	var (
+		tag   string = "internal.services.user.bulk."
+		res   types.BulkUserResponse
+		added []models.Email
+	)
+
+	res.Atomic = atomic
+	res.Items = []types.BulkUserItemResponse{}
+
+	if size > s.Options.BulkMaxItems {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": "Bulk Limit Exceeded",
+		}).Error("too many items in bulk request")
+
+		return res, ErrBulkLimitExceeded.WithDetails(map[string]int{"max": s.Options.BulkMaxItems})
+	}
+
+	if atomic {
+		err := s.UserRepository.Transaction(ctx, func(repository repositories.IUserRepository) error {
+			service := &UserService{
+				UserRepository: repository,
+				Options:        s.Options,
+			}
+
+			for i := 0; i < size; i++ {
+				item, emails, err := fn(service, i)
+
+				if err != nil {
+					return bulkItemError(i, err)
+				}
+
+				item.Index = i
+
+				res.Items = append(res.Items, item)
+
+				added = append(added, emails...)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "02",
+				"error": err.Error(),
+			}).Error("failed to run atomic bulk request, rolled back")
+
+			return res, err
+		}
+
+		res.Succeeded = size
+
+		s.sendVerifications(ctx, added)
+
+		return res, nil
+	}
+
+	for i := 0; i < size; i++ {
+		item, emails, err := fn(s, i)
+
+		if err != nil {
+			item = bulkItemFailure(err)
+
+			res.Failed++
+		} else {
+			res.Succeeded++
+
+			s.sendVerifications(ctx, emails)
+		}
+
+		item.Index = i
+
+		res.Items = append(res.Items, item)
+	}
+
+	return res, nil
+}
+
+func (s *UserService) sendVerifications(ctx context.Context, addedEmails []models.Email) {
+	var tag string = "internal.services.user.sendVerifications."
+
+	for _, email := range addedEmails {
+		if err := sendVerification(ctx, s.Options.Notifier, s.Options.AppKey, s.Options.VerificationTTL, email); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"email": email.ID,
+				"error": err.Error(),
+			}).Warn("failed to send email verification, it can be resent later")
+		}
+	}
+}
+
+func bulkItemError(index int, err error) error {
+	var domainError *types.Error
+
+	if errors.As(err, &domainError) && domainError.Details != nil {
+		return domainError.WithDetails(map[string]any{
+			"index":   index,
+			"details": domainError.Details,
+		})
+	}
+
+	if errors.As(err, &domainError) {
+		return domainError.WithDetails(map[string]any{
+			"index": index,
+		})
+	}
+
+	return ErrBulkItemFailed.WithDetails(map[string]any{
+		"index": index,
+	})
+}
+
+func bulkItemFailure(err error) types.BulkUserItemResponse {
+	var domainError *types.Error
+
+	if errors.As(err, &domainError) {
+		return types.BulkUserItemResponse{
+			Status:  "failed",
+			Error:   domainError.Code,
+			Details: domainError.Details,
+		}
+	}
+
+	return types.BulkUserItemResponse{
+		Status: "failed",
+		Error:  ErrBulkItemFailed.Code,
+	}
+}
+
+func normalizeEmails(policy *emails.Policy, addresses []string) ([]string, map[string]map[string]string) {
+	var (
+		normalized []string          = make([]string, len(addresses))
+		errs       map[string]string = map[string]string{}
+	)
+
+	for i, v := range addresses {
+		normalized[i] = policy.Normalize(v)
+
+		if err := policy.Validate(normalized[i]); err != nil {
+			errs[strconv.Itoa(i)] = err.Error()
+		}
+	}
+
+	if len(errs) > 0 {
+		return normalized, map[string]map[string]string{"emails": errs}
+	}
+
+	return normalized, nil
+}
+
+func hasDuplicateEmail(addresses []string) bool {
+	for i := 0; i < len(addresses); i++ {
+		for j := i + 1; j < len(addresses); j++ {
+			if addresses[i] == addresses[j] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func importColumn(record []string, columns map[string]int, name string) string {
+	i, ok := columns[name]
+
+	if !ok || i >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[i])
+}
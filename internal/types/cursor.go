@@ -0,0 +1,33 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+type Cursor struct {
+	Keys   []string `json:"k"`
+	Values []any    `json:"v"`
+}
+
+func EncodeCursor(cursor Cursor) string {
+	cursorJSON, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(cursorJSON)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	var cursor Cursor
+
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(cursorJSON, &cursor); err != nil {
+		return nil, err
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,60 @@
+package types
+
+type ErrorKind int
+
+const (
+	ErrorKindInternal ErrorKind = iota
+	ErrorKindNotFound
+	ErrorKindConflict
+	ErrorKindValidation
+	ErrorKindPreconditionFailed
+	ErrorKindPreconditionRequired
+)
+
+type Error struct {
+	Kind    ErrorKind
+	Code    string
+	Details any
+}
+
+func (e *Error) Error() string {
+	return e.Code
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.Kind == e.Kind && t.Code == e.Code
+}
+
+func (e *Error) WithDetails(details any) *Error {
+	return &Error{
+		Kind:    e.Kind,
+		Code:    e.Code,
+		Details: details,
+	}
+}
+
+func NewInternalError(code string) *Error {
+	return &Error{Kind: ErrorKindInternal, Code: code}
+}
+
+func NewNotFoundError(code string) *Error {
+	return &Error{Kind: ErrorKindNotFound, Code: code}
+}
+
+func NewConflictError(code string) *Error {
+	return &Error{Kind: ErrorKindConflict, Code: code}
+}
+
+func NewValidationError(code string) *Error {
+	return &Error{Kind: ErrorKindValidation, Code: code}
+}
+
+func NewPreconditionFailedError(code string) *Error {
+	return &Error{Kind: ErrorKindPreconditionFailed, Code: code}
+}
+
+func NewPreconditionRequiredError(code string) *Error {
+	return &Error{Kind: ErrorKindPreconditionRequired, Code: code}
+}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	FilterEqual            string = "eq"
+	FilterNotEqual         string = "ne"
+	FilterLike             string = "like"
+	FilterIn               string = "in"
+	FilterGreaterThan      string = "gt"
+	FilterGreaterThanEqual string = "gte"
+	FilterLessThan         string = "lt"
+	FilterLessThanEqual    string = "lte"
+)
+
+type Filter struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+type FilterRule struct {
+	Operators []string
+	Datetime  bool
+}
+
+var UserFilterRules = map[string]FilterRule{
+	"id": {
+		Operators: []string{FilterEqual, FilterNotEqual, FilterIn},
+	},
+	"name": {
+		Operators: []string{FilterEqual, FilterNotEqual, FilterLike, FilterIn},
+	},
+	"createdAt": {
+		Operators: []string{FilterEqual, FilterNotEqual, FilterGreaterThan, FilterGreaterThanEqual, FilterLessThan, FilterLessThanEqual},
+		Datetime:  true,
+	},
+	"updatedAt": {
+		Operators: []string{FilterEqual, FilterNotEqual, FilterGreaterThan, FilterGreaterThanEqual, FilterLessThan, FilterLessThanEqual},
+		Datetime:  true,
+	},
+	"emails.email": {
+		Operators: []string{FilterEqual, FilterNotEqual, FilterLike, FilterIn},
+	},
+}
+
+var UserSearchFields = []string{"name", "emails.email"}
+
+var UserFields = []string{"id", "name", "createdAt", "updatedAt", "version"}
+
+var UserIncludes = []string{"emails"}
+
+var filterPattern = regexp.MustCompile(`^filter\[([^\[\]]+)\](?:\[([^\[\]]*)\])?$`)
+
+func ParseFilters(values url.Values) []Filter {
+	var (
+		filters []Filter
+		keys    []string
+	)
+
+	for key := range values {
+		if strings.HasPrefix(key, "filter[") {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		match := filterPattern.FindStringSubmatch(key)
+
+		if match == nil {
+			filters = append(filters, Filter{Field: key})
+
+			continue
+		}
+
+		operator := match[2]
+
+		if operator == "" {
+			operator = FilterEqual
+		}
+
+		for _, value := range values[key] {
+			filters = append(filters, Filter{
+				Field:    match[1],
+				Operator: operator,
+				Value:    value,
+			})
+		}
+	}
+
+	return filters
+}
+
+func FilterValidation(rules map[string]FilterRule) validation.RuleFunc {
+	return func(value interface{}) error {
+		filters, ok := value.([]Filter)
+
+		if !ok {
+			return errors.New("the filter is not a valid filter")
+		}
+
+		errs := validation.Errors{}
+
+		for _, filter := range filters {
+			if _, ok := errs[filter.Field]; ok {
+				continue
+			}
+
+			rule, ok := rules[filter.Field]
+
+			if !ok {
+				errs[filter.Field] = errors.New("the " + filter.Field + " is not a filterable field")
+
+				continue
+			}
+
+			if !slices.Contains(rule.Operators, filter.Operator) {
+				errs[filter.Field] = errors.New("the " + filter.Operator + " is not an allowed operator for " + filter.Field)
+
+				continue
+			}
+
+			if rule.Datetime {
+				if err := DatetimeValidation(filter.Field)(filter.Value); err != nil {
+					errs[filter.Field] = err
+
+					continue
+				}
+			}
+
+			if err := BlacklistValidation(filter.Field)(filter.Value); err != nil {
+				errs[filter.Field] = err
+			}
+		}
+
+		if len(errs) == 0 {
+			return nil
+		}
+
+		return errs
+	}
+}
+
+func SplitList(value string) []string {
+	var list []string
+
+	if value == "" {
+		return list
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		list = append(list, strings.TrimSpace(item))
+	}
+
+	return list
+}
+
+func SearchFieldsValidation(fields []string) validation.RuleFunc {
+	return func(value interface{}) error {
+		val, ok := value.(string)
+
+		if !ok {
+			return errors.New("the searchFields is not a string")
+		}
+
+		for _, field := range SplitList(val) {
+			if !slices.Contains(fields, field) {
+				return errors.New("the " + field + " is not a searchable field")
+			}
+		}
+
+		return nil
+	}
+}
+
+func WhitelistValidation(field string, allowed []string) validation.RuleFunc {
+	return func(value interface{}) error {
+		val, ok := value.(string)
+
+		if !ok {
+			return errors.New("the " + field + " is not a string")
+		}
+
+		for _, item := range SplitList(val) {
+			if !slices.Contains(allowed, item) {
+				return errors.New("the " + item + " is not allowed for " + field)
+			}
+		}
+
+		return nil
+	}
+}
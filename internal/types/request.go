@@ -6,25 +6,96 @@ type CreateUserRequest struct {
 }
 
 type PaginatorRequest struct {
-	Page                  string `query:"page" json:"page"`
-	Limit                 string `query:"limit" json:"limit"`
-	OrderBy               string `query:"orderBy" json:"orderBy"`
-	SortBy                string `query:"sortBy" json:"sortBy"`
-	Search                string `query:"search" json:"search"`
-	DisableCalculateTotal string `query:"disableCalculateTotal" json:"disableCalculateTotal"`
+	Page                  string   `query:"page" json:"page"`
+	Limit                 string   `query:"limit" json:"limit"`
+	OrderBy               string   `query:"orderBy" json:"orderBy"`
+	SortBy                string   `query:"sortBy" json:"sortBy"`
+	Sort                  string   `query:"sort" json:"sort"`
+	Search                string   `query:"search" json:"search"`
+	SearchFields          string   `query:"searchFields" json:"searchFields"`
+	DisableCalculateTotal string   `query:"disableCalculateTotal" json:"disableCalculateTotal"`
+	Cursor                string   `query:"cursor" json:"cursor"`
+	After                 string   `query:"after" json:"after"`
+	Before                string   `query:"before" json:"before"`
+	Filter                []Filter `query:"-" json:"filter"`
 }
 
 type ReadUserRequest struct {
 	PaginatorRequest
-	ID string `query:"id" json:"id"`
+	ID      string `query:"id" json:"id"`
+	Fields  string `query:"fields" json:"fields"`
+	Include string `query:"include" json:"include"`
+}
+
+type ImportUserRequest struct {
+	DryRun string `query:"dryRun" form:"dryRun" json:"dryRun"`
+}
+
+type ExportUserRequest struct {
+	Format       string   `query:"format" json:"format"`
+	OrderBy      string   `query:"orderBy" json:"orderBy"`
+	SortBy       string   `query:"sortBy" json:"sortBy"`
+	Sort         string   `query:"sort" json:"sort"`
+	Search       string   `query:"search" json:"search"`
+	SearchFields string   `query:"searchFields" json:"searchFields"`
+	Filter       []Filter `query:"-" json:"filter"`
+	ID           string   `query:"id" json:"id"`
+}
+
+type FindUserRequest struct {
+	ID string `param:"id" json:"id"`
 }
 
 type UpdateUserRequest struct {
-	ID     string   `param:"id" json:"id"`
-	Name   string   `json:"name"`
-	Emails []string `json:"emails"`
+	ID      string   `param:"id" json:"id"`
+	Name    string   `json:"name"`
+	Emails  []string `json:"emails"`
+	Version *int64   `json:"version"`
 }
 
 type DeleteUserRequest struct {
-	ID string `param:"id" json:"id"`
+	ID      string `param:"id" json:"id"`
+	Version *int64 `json:"version"`
+}
+
+type BulkCreateUserRequest struct {
+	Atomic string              `query:"atomic" json:"atomic"`
+	Items  []CreateUserRequest `json:"items"`
+}
+
+type BulkUpdateUserRequest struct {
+	Atomic string              `query:"atomic" json:"atomic"`
+	Items  []UpdateUserRequest `json:"items"`
+}
+
+type BulkDeleteUserRequest struct {
+	Atomic   string           `query:"atomic" json:"atomic"`
+	IDs      []string         `json:"ids"`
+	Versions map[string]int64 `json:"versions"`
+}
+
+type ReadEmailRequest struct {
+	UserID string `param:"id" json:"id"`
+}
+
+type CreateEmailRequest struct {
+	UserID  string `param:"id" json:"id"`
+	Email   string `json:"email"`
+	Primary bool   `json:"primary"`
+}
+
+type VerifyEmailRequest struct {
+	UserID  string `param:"id" json:"id"`
+	EmailID string `param:"emailId" json:"emailId"`
+	Token   string `json:"token"`
+}
+
+type ResendEmailVerificationRequest struct {
+	UserID  string `param:"id" json:"id"`
+	EmailID string `param:"emailId" json:"emailId"`
+}
+
+type DeleteEmailRequest struct {
+	UserID  string `param:"id" json:"id"`
+	EmailID string `param:"emailId" json:"emailId"`
 }
@@ -1,9 +1,11 @@
 package types
 
 type PaginatorResponse struct {
-	Records  any   `json:"records"`
-	Total    int64 `json:"total"`
-	NextPage bool  `json:"nextPage"`
+	Records    any    `json:"records"`
+	Total      int64  `json:"total"`
+	NextPage   bool   `json:"nextPage"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
 
 type MainResponse struct {
@@ -11,3 +13,46 @@ type MainResponse struct {
 	Description string `json:"description"`
 	Data        any    `json:"data"`
 }
+
+type HealthResponse struct {
+	Status string                         `json:"status"`
+	Checks map[string]HealthCheckResponse `json:"checks"`
+}
+
+type HealthCheckResponse struct {
+	Status  string `json:"status"`
+	Latency string `json:"latency,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Details any    `json:"details,omitempty"`
+}
+
+type ImportUserResponse struct {
+	DryRun  bool                 `json:"dryRun"`
+	Valid   int                  `json:"valid"`
+	Created int                  `json:"created"`
+	Failed  []ImportUserRowError `json:"failed"`
+}
+
+type ImportUserRowError struct {
+	Row    int `json:"row"`
+	Errors any `json:"errors"`
+}
+
+type BulkUserResponse struct {
+	Atomic    bool                   `json:"atomic"`
+	Succeeded int                    `json:"succeeded"`
+	Failed    int                    `json:"failed"`
+	Items     []BulkUserItemResponse `json:"items"`
+}
+
+type BulkUserItemResponse struct {
+	Index   int    `json:"index"`
+	Status  string `json:"status"`
+	ID      string `json:"id,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
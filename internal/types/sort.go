@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+var UserSortFields = map[string]string{
+	"id":        "id",
+	"name":      "name",
+	"createdAt": "created_at",
+	"updatedAt": "updated_at",
+}
+
+func SortKeys(fields map[string]string) []interface{} {
+	var keys []interface{}
+
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func ParseSort(value string) []SortField {
+	var sorts []SortField
+
+	for _, item := range SplitList(value) {
+		sorts = append(sorts, SortField{
+			Field: strings.TrimPrefix(item, "-"),
+			Desc:  strings.HasPrefix(item, "-"),
+		})
+	}
+
+	return sorts
+}
+
+func SortValidation(fields map[string]string) validation.RuleFunc {
+	return func(value interface{}) error {
+		val, ok := value.(string)
+
+		if !ok {
+			return errors.New("the sort is not a string")
+		}
+
+		seen := map[string]bool{}
+
+		for _, sort := range ParseSort(val) {
+			if fields[sort.Field] == "" {
+				return errors.New("the " + sort.Field + " is not a sortable field")
+			}
+
+			if seen[sort.Field] {
+				return errors.New("the " + sort.Field + " is sorted more than once")
+			}
+
+			seen[sort.Field] = true
+		}
+
+		return nil
+	}
+}
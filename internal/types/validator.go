@@ -53,6 +53,26 @@ func DatetimeValidation(field string) validation.RuleFunc {
 	}
 }
 
+func CursorValidation(field string) validation.RuleFunc {
+	return func(value interface{}) error {
+		val, ok := value.(string)
+
+		if !ok {
+			return errors.New("the " + field + " is not a cursor")
+		}
+
+		if val == "" {
+			return nil
+		}
+
+		if _, err := DecodeCursor(val); err != nil {
+			return errors.New("the " + field + " is not a valid cursor")
+		}
+
+		return nil
+	}
+}
+
 func (r CreateUserRequest) Validate() interface{} {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.Name, validation.Required, validation.By(BlacklistValidation("name"))),
@@ -64,11 +84,44 @@ func (r ReadUserRequest) Validate() interface{} {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.Page, is.Digit),
 		validation.Field(&r.Limit, is.Digit),
-		validation.Field(&r.OrderBy, validation.In("id", "name", "createdAt", "updatedAt")),
+		validation.Field(&r.OrderBy, validation.In(SortKeys(UserSortFields)...)),
 		validation.Field(&r.SortBy, validation.In("asc", "desc")),
+		validation.Field(&r.Sort, validation.By(SortValidation(UserSortFields))),
 		validation.Field(&r.Search, validation.By(BlacklistValidation("search"))),
+		validation.Field(&r.SearchFields, validation.By(SearchFieldsValidation(UserSearchFields))),
 		validation.Field(&r.DisableCalculateTotal, validation.In("true", "false")),
+		validation.Field(&r.Cursor, validation.In("true", "false")),
+		validation.Field(&r.After, validation.By(CursorValidation("after"))),
+		validation.Field(&r.Before, validation.By(CursorValidation("before")), validation.When(r.After != "", validation.Empty.Error("cannot be used together with after"))),
+		validation.Field(&r.Filter, validation.By(FilterValidation(UserFilterRules))),
 		validation.Field(&r.ID, is.UUID),
+		validation.Field(&r.Fields, validation.By(WhitelistValidation("fields", UserFields))),
+		validation.Field(&r.Include, validation.By(WhitelistValidation("include", UserIncludes))),
+	)
+}
+
+func (r ImportUserRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.DryRun, validation.In("true", "false")),
+	)
+}
+
+func (r ExportUserRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Format, validation.Required, validation.In("csv", "xlsx")),
+		validation.Field(&r.OrderBy, validation.In(SortKeys(UserSortFields)...)),
+		validation.Field(&r.SortBy, validation.In("asc", "desc")),
+		validation.Field(&r.Sort, validation.By(SortValidation(UserSortFields))),
+		validation.Field(&r.Search, validation.By(BlacklistValidation("search"))),
+		validation.Field(&r.SearchFields, validation.By(SearchFieldsValidation(UserSearchFields))),
+		validation.Field(&r.Filter, validation.By(FilterValidation(UserFilterRules))),
+		validation.Field(&r.ID, is.UUID),
+	)
+}
+
+func (r FindUserRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ID, validation.Required, is.UUID),
 	)
 }
 
@@ -85,3 +138,59 @@ func (r DeleteUserRequest) Validate() interface{} {
 		validation.Field(&r.ID, validation.Required, is.UUID),
 	)
 }
+
+func (r BulkCreateUserRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Atomic, validation.In("true", "false")),
+		validation.Field(&r.Items, validation.Required),
+	)
+}
+
+func (r BulkUpdateUserRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Atomic, validation.In("true", "false")),
+		validation.Field(&r.Items, validation.Required),
+	)
+}
+
+func (r BulkDeleteUserRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Atomic, validation.In("true", "false")),
+		validation.Field(&r.IDs, validation.Required),
+	)
+}
+
+func (r ReadEmailRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required, is.UUID),
+	)
+}
+
+func (r CreateEmailRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required, is.UUID),
+		validation.Field(&r.Email, validation.Required, is.Email),
+	)
+}
+
+func (r VerifyEmailRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required, is.UUID),
+		validation.Field(&r.EmailID, validation.Required, is.UUID),
+		validation.Field(&r.Token, validation.Required),
+	)
+}
+
+func (r ResendEmailVerificationRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required, is.UUID),
+		validation.Field(&r.EmailID, validation.Required, is.UUID),
+	)
+}
+
+func (r DeleteEmailRequest) Validate() interface{} {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required, is.UUID),
+		validation.Field(&r.EmailID, validation.Required, is.UUID),
+	)
+}
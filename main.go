@@ -1,7 +1,13 @@
 package main
 
-import "github.com/MrAndreID/goapi/applications"
+import (
+	"os"
+
+	"github.com/MrAndreID/goapi/applications"
+)
 
 func main() {
-	applications.Start(true)
+	if _, err := applications.Start(true); err != nil {
+		os.Exit(1)
+	}
 }
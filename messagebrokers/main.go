@@ -3,6 +3,9 @@ package messagebrokers
 import (
 	"context"
 	"errors"
+	"time"
+
+	"github.com/MrAndreID/goapi/retries"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/segmentio/kafka-go"
@@ -17,10 +20,12 @@ type MessageBroker struct {
 	Password   string
 	Name       string
 	Partition  int
+	Retry      retries.Policy
 }
 
 type MessageBrokerConnection struct {
 	Name     string
+	Address  string
 	RabbitMQ *RabbitMQConnection
 	Kafka    *kafka.Conn
 }
@@ -36,14 +41,20 @@ func New(messageBroker *MessageBroker) (*MessageBrokerConnection, error) {
 		err                     error
 	)
 
-	switch messageBroker.Connection {
-	case "rabbitmq":
-		messageBrokerConnection, err = messageBroker.RabbitMQ()
-	case "kafka":
-		messageBrokerConnection, err = messageBroker.Kafka()
-	default:
-		err = errors.New("Message Broker Connection Not Found")
-	}
+	err = retries.Do(context.Background(), messageBroker.Retry, "messageBroker", func() error {
+		var err error
+
+		switch messageBroker.Connection {
+		case "rabbitmq":
+			messageBrokerConnection, err = messageBroker.RabbitMQ()
+		case "kafka":
+			messageBrokerConnection, err = messageBroker.Kafka()
+		default:
+			err = retries.Permanent(errors.New("Message Broker Connection Not Found"))
+		}
+
+		return err
+	})
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -81,6 +92,8 @@ func (messageBroker *MessageBroker) RabbitMQ() (*MessageBrokerConnection, error)
 			"error": err.Error(),
 		}).Error("failed to connect rabbitmq")
 
+		rabbitMQConnection.Close()
+
 		return nil, err
 	}
 
@@ -105,19 +118,64 @@ func (messageBroker *MessageBroker) Kafka() (*MessageBrokerConnection, error) {
 	}
 
 	return &MessageBrokerConnection{
-		Kafka: kafkaConnection,
+		Address: messageBroker.Host + ":" + messageBroker.Port,
+		Kafka:   kafkaConnection,
 	}, nil
 }
 
-func (messageBrokerConnection *MessageBrokerConnection) Close() {
+func (messageBrokerConnection *MessageBrokerConnection) Ping(ctx context.Context) error {
+	switch messageBrokerConnection.Name {
+	case "rabbitmq":
+		if messageBrokerConnection.RabbitMQ.Connection.IsClosed() {
+			return errors.New("RabbitMQ Connection Is Closed")
+		}
+
+		if messageBrokerConnection.RabbitMQ.Channel.IsClosed() {
+			return errors.New("RabbitMQ Channel Is Closed")
+		}
+
+		return nil
+	case "kafka":
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, 5*time.Second)
+
+			defer cancel()
+		}
+
+		kafkaConnection, err := kafka.DialContext(ctx, "tcp", messageBrokerConnection.Address)
+
+		if err != nil {
+			return err
+		}
+
+		defer kafkaConnection.Close()
+
+		deadline, _ := ctx.Deadline()
+
+		if err := kafkaConnection.SetDeadline(deadline); err != nil {
+			return err
+		}
+
+		_, err = kafkaConnection.Brokers()
+
+		return err
+	default:
+		return errors.New("Message Broker Connection Not Found")
+	}
+}
+
+func (messageBrokerConnection *MessageBrokerConnection) Close() error {
 	var err error
 
 	switch messageBrokerConnection.Name {
 	case "rabbitmq":
-		messageBrokerConnection.RabbitMQ.Connection.Close()
-		messageBrokerConnection.RabbitMQ.Channel.Close()
+		if err = messageBrokerConnection.RabbitMQ.Channel.Close(); err == nil {
+			err = messageBrokerConnection.RabbitMQ.Connection.Close()
+		}
 	case "kafka":
-		messageBrokerConnection.Kafka.Close()
+		err = messageBrokerConnection.Kafka.Close()
 	default:
 		err = errors.New("Message Broker Connection Not Found")
 	}
@@ -127,5 +185,9 @@ func (messageBrokerConnection *MessageBrokerConnection) Close() {
 			"tag":   "Message-Brokers.Main.Close.01",
 			"error": err.Error(),
 		}).Error("failed to close connection (message broker)")
+
+		return err
 	}
+
+	return nil
 }
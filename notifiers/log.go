@@ -0,0 +1,34 @@
+package notifiers
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+type LogNotifier struct {
+	Debug bool
+}
+
+func NewLogNotifier(notifier *Notifier) *LogNotifier {
+	return &LogNotifier{
+		Debug: notifier.Debug,
+	}
+}
+
+func (n *LogNotifier) Send(ctx context.Context, message Message) error {
+	body := "[REDACTED]"
+
+	if n.Debug {
+		body = message.Body
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"tag":     "Notifiers.Log.Send.01",
+		"to":      message.To,
+		"subject": message.Subject,
+		"body":    body,
+	}).Info("notification")
+
+	return nil
+}
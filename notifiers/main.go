@@ -0,0 +1,48 @@
+package notifiers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Notifier struct {
+	Connection string
+	Host       string
+	Port       string
+	Username   string
+	Password   string
+	From       string
+	Timeout    time.Duration
+	Debug      bool
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type INotifier interface {
+	Send(context.Context, Message) error
+}
+
+func New(notifier *Notifier) (INotifier, error) {
+	switch notifier.Connection {
+	case "", "log":
+		return NewLogNotifier(notifier), nil
+	case "smtp":
+		return NewSMTPNotifier(notifier), nil
+	}
+
+	err := errors.New("Notifier Connection Not Found")
+
+	logrus.WithFields(logrus.Fields{
+		"tag":   "Notifiers.Main.New.01",
+		"error": err.Error(),
+	}).Error("failed to initiate notifier")
+
+	return nil, err
+}
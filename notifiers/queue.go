@@ -0,0 +1,91 @@
+package notifiers
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrQueueFull   = errors.New("Notifier Queue is Full")
+	ErrQueueClosed = errors.New("Notifier Queue is Closed")
+)
+
+type QueueNotifier struct {
+	Notifier INotifier
+	messages chan Message
+	mutex    sync.RWMutex
+	closed   bool
+	workers  sync.WaitGroup
+}
+
+func NewQueueNotifier(notifier INotifier, size, workers int) *QueueNotifier {
+	queue := &QueueNotifier{
+		Notifier: notifier,
+		messages: make(chan Message, max(size, 1)),
+	}
+
+	for i := 0; i < max(workers, 1); i++ {
+		queue.workers.Add(1)
+
+		go queue.work()
+	}
+
+	return queue
+}
+
+func (n *QueueNotifier) Send(ctx context.Context, message Message) error {
+	var tag string = "Notifiers.Queue.Send."
+
+	n.mutex.RLock()
+
+	defer n.mutex.RUnlock()
+
+	if n.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case n.messages <- message:
+		return nil
+	default:
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": ErrQueueFull.Error(),
+		}).Warn("failed to queue notification")
+
+		return ErrQueueFull
+	}
+}
+
+func (n *QueueNotifier) Close() {
+	n.mutex.Lock()
+
+	if !n.closed {
+		n.closed = true
+
+		close(n.messages)
+	}
+
+	n.mutex.Unlock()
+
+	n.workers.Wait()
+}
+
+func (n *QueueNotifier) work() {
+	var tag string = "Notifiers.Queue.Work."
+
+	defer n.workers.Done()
+
+	for message := range n.messages {
+		if err := n.Notifier.Send(context.Background(), message); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "01",
+				"to":    message.To,
+				"error": err.Error(),
+			}).Warn("failed to send queued notification")
+		}
+	}
+}
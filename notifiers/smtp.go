@@ -0,0 +1,159 @@
+package notifiers
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type SMTPNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+func NewSMTPNotifier(notifier *Notifier) *SMTPNotifier {
+	return &SMTPNotifier{
+		Host:     notifier.Host,
+		Port:     notifier.Port,
+		Username: notifier.Username,
+		Password: notifier.Password,
+		From:     notifier.From,
+		Timeout:  notifier.Timeout,
+	}
+}
+
+func (n *SMTPNotifier) Send(ctx context.Context, message Message) error {
+	var tag string = "Notifiers.SMTP.Send."
+
+	if n.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, n.Timeout)
+
+		defer cancel()
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(n.Host, n.Port))
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "01",
+			"error": err.Error(),
+		}).Error("failed to connect smtp server")
+
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, n.Host)
+
+	if err != nil {
+		conn.Close()
+
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "02",
+			"error": err.Error(),
+		}).Error("failed to initiate smtp client")
+
+		return err
+	}
+
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.Host}); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "03",
+				"error": err.Error(),
+			}).Error("failed to start tls")
+
+			return err
+		}
+	}
+
+	if n.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.Username, n.Password, n.Host)); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"tag":   tag + "04",
+				"error": err.Error(),
+			}).Error("failed to authenticate smtp client")
+
+			return err
+		}
+	}
+
+	if err := client.Mail(n.From); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "05",
+			"error": err.Error(),
+		}).Error("failed to set smtp sender")
+
+		return err
+	}
+
+	if err := client.Rcpt(message.To); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "06",
+			"error": err.Error(),
+		}).Error("failed to set smtp recipient")
+
+		return err
+	}
+
+	writer, err := client.Data()
+
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "07",
+			"error": err.Error(),
+		}).Error("failed to open smtp data")
+
+		return err
+	}
+
+	if _, err := writer.Write([]byte(n.message(message))); err != nil {
+		writer.Close()
+
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "08",
+			"error": err.Error(),
+		}).Error("failed to write smtp data")
+
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "09",
+			"error": err.Error(),
+		}).Error("failed to send smtp data")
+
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (n *SMTPNotifier) message(message Message) string {
+	header := strings.NewReplacer("\r", "", "\n", "")
+
+	return "From: " + header.Replace(n.From) + "\r\n" +
+		"To: " + header.Replace(message.To) + "\r\n" +
+		"Subject: " + header.Replace(message.Subject) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n")
+}
@@ -3,6 +3,9 @@ package objectstorages
 import (
 	"context"
 	"errors"
+	"net/http"
+
+	"github.com/MrAndreID/goapi/retries"
 
 	"github.com/MrAndreID/gopackage"
 	"github.com/minio/minio-go/v7"
@@ -17,11 +20,13 @@ type ObjectStorage struct {
 	Username   string
 	Password   string
 	SSL        bool
+	Retry      retries.Policy
 }
 
 type ObjectStorageConnection struct {
-	Minio     *minio.Client
-	SeaweedFS *gopackage.SeaweedFSData
+	Minio          *minio.Client
+	MinioTransport *http.Transport
+	SeaweedFS      *gopackage.SeaweedFSData
 }
 
 func New(objectStorage *ObjectStorage) (*ObjectStorageConnection, error) {
@@ -30,14 +35,20 @@ func New(objectStorage *ObjectStorage) (*ObjectStorageConnection, error) {
 		err               error
 	)
 
-	switch objectStorage.Connection {
-	case "minio":
-		objectStorageData, err = objectStorage.Minio()
-	case "seaweedfs":
-		objectStorageData, err = objectStorage.SeaweedFS()
-	default:
-		err = errors.New("Object Storage Connection Not Found")
-	}
+	err = retries.Do(context.Background(), objectStorage.Retry, "objectStorage", func() error {
+		var err error
+
+		switch objectStorage.Connection {
+		case "minio":
+			objectStorageData, err = objectStorage.Minio()
+		case "seaweedfs":
+			objectStorageData, err = objectStorage.SeaweedFS()
+		default:
+			err = retries.Permanent(errors.New("Object Storage Connection Not Found"))
+		}
+
+		return err
+	})
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -52,26 +63,25 @@ func New(objectStorage *ObjectStorage) (*ObjectStorageConnection, error) {
 }
 
 func (objectStorage *ObjectStorage) Minio() (*ObjectStorageConnection, error) {
-	var (
-		tag       string = "Object-Storages.Main.Minio."
-		keyBucket string = "ping"
-	)
+	var tag string = "Object-Storages.Main.Minio."
 
-	minioClient, err := minio.New(objectStorage.Host+":"+objectStorage.Port, &minio.Options{
-		Creds:  credentials.NewStaticV4(objectStorage.Username, objectStorage.Password, ""),
-		Secure: objectStorage.SSL,
-	})
+	minioTransport, err := minio.DefaultTransport(objectStorage.SSL)
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "01",
 			"error": err.Error(),
-		}).Error("failed to connect minio")
+		}).Error("failed to create minio transport")
 
 		return nil, err
 	}
 
-	_, err = minioClient.BucketExists(context.Background(), keyBucket)
+	minioClient, err := minio.New(objectStorage.Host+":"+objectStorage.Port, &minio.Options{
+		Creds:     credentials.NewStaticV4(objectStorage.Username, objectStorage.Password, ""),
+		Secure:    objectStorage.SSL,
+		Transport: minioTransport,
+	})
+
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"tag":   tag + "02",
@@ -81,9 +91,21 @@ func (objectStorage *ObjectStorage) Minio() (*ObjectStorageConnection, error) {
 		return nil, err
 	}
 
-	return &ObjectStorageConnection{
-		Minio: minioClient,
-	}, nil
+	objectStorageConnection := &ObjectStorageConnection{
+		Minio:          minioClient,
+		MinioTransport: minioTransport,
+	}
+
+	if err := objectStorageConnection.Ping(context.Background()); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"tag":   tag + "03",
+			"error": err.Error(),
+		}).Error("failed to connect minio")
+
+		return nil, err
+	}
+
+	return objectStorageConnection, nil
 }
 
 func (objectStorage *ObjectStorage) SeaweedFS() (*ObjectStorageConnection, error) {
@@ -104,3 +126,54 @@ func (objectStorage *ObjectStorage) SeaweedFS() (*ObjectStorageConnection, error
 		SeaweedFS: seaweedFSClient,
 	}, nil
 }
+
+func (objectStorageConnection *ObjectStorageConnection) Ping(ctx context.Context) error {
+	switch {
+	case objectStorageConnection.Minio != nil:
+		_, err := objectStorageConnection.Minio.BucketExists(ctx, "ping")
+
+		return err
+	case objectStorageConnection.SeaweedFS != nil:
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, objectStorageConnection.SeaweedFS.URL, nil)
+
+		if err != nil {
+			return err
+		}
+
+		response, err := http.DefaultClient.Do(request)
+
+		if err != nil {
+			return err
+		}
+
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return errors.New("SeaweedFS Responded With " + response.Status)
+		}
+
+		return nil
+	default:
+		return errors.New("Object Storage Connection Not Found")
+	}
+}
+
+func (objectStorageConnection *ObjectStorageConnection) Close() error {
+	switch {
+	case objectStorageConnection.Minio != nil:
+		objectStorageConnection.MinioTransport.CloseIdleConnections()
+	case objectStorageConnection.SeaweedFS != nil:
+		// SeaweedFS is reached through one-off HTTP requests, so there is nothing to release.
+	default:
+		err := errors.New("Object Storage Connection Not Found")
+
+		logrus.WithFields(logrus.Fields{
+			"tag":   "Object-Storages.Main.Close.01",
+			"error": err.Error(),
+		}).Error("failed to close connection (object storage)")
+
+		return err
+	}
+
+	return nil
+}
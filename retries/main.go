@@ -0,0 +1,122 @@
+package retries
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Policy struct {
+	MaxAttempts  int           `env:"MAX_ATTEMPTS" envDefault:"5"`
+	InitialDelay time.Duration `env:"INITIAL_DELAY" envDefault:"1s"`
+	MaxDelay     time.Duration `env:"MAX_DELAY" envDefault:"15s"`
+	Jitter       float64       `env:"JITTER" envDefault:"0.2"`
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+func Do(ctx context.Context, policy Policy, name string, fn func() error) error {
+	var (
+		tag       string = "Retries.Main.Do."
+		attempts  int    = max(policy.MaxAttempts, 1)
+		permanent *permanentError
+	)
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+
+		if err == nil {
+			if attempt > 1 {
+				logrus.WithFields(logrus.Fields{
+					"tag":     tag + "01",
+					"name":    name,
+					"attempt": attempt,
+				}).Info("connected after retrying")
+			}
+
+			return nil
+		}
+
+		if errors.As(err, &permanent) {
+			return permanent.err
+		}
+
+		if attempt >= attempts {
+			logrus.WithFields(logrus.Fields{
+				"tag":     tag + "02",
+				"name":    name,
+				"attempt": attempt,
+				"error":   err.Error(),
+			}).Error("failed to connect after the last attempt")
+
+			return err
+		}
+
+		wait := policy.Delay(attempt)
+
+		logrus.WithFields(logrus.Fields{
+			"tag":         tag + "03",
+			"name":        name,
+			"attempt":     attempt,
+			"maxAttempts": attempts,
+			"retryIn":     wait.String(),
+			"error":       err.Error(),
+		}).Warn("failed to connect, retrying")
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+
+			logrus.WithFields(logrus.Fields{
+				"tag":     tag + "04",
+				"name":    name,
+				"attempt": attempt,
+				"error":   ctx.Err().Error(),
+			}).Error("stopped retrying, context is done")
+
+			return ctx.Err()
+		}
+	}
+}
+
+func (policy Policy) Delay(attempt int) time.Duration {
+	delay := policy.InitialDelay
+
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+
+			break
+		}
+	}
+
+	if policy.Jitter <= 0 || delay <= 0 {
+		return delay
+	}
+
+	jitter := time.Duration(float64(delay) * policy.Jitter * (rand.Float64()*2 - 1))
+
+	return max(delay+jitter, 0)
+}
@@ -0,0 +1,1053 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MrAndreID/goapi/emails"
+	"github.com/MrAndreID/goapi/internal/handlers"
+	"github.com/MrAndreID/goapi/internal/services"
+	"github.com/MrAndreID/goapi/internal/types"
+	"github.com/MrAndreID/goapi/notifiers"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var emailID string
+
+var emailHandlerFunc = handlers.NewEmailHandler(server.Group, server.Container.EmailService, handlers.EmailHandlerOptions{})
+
+const (
+	emailTestUserID  string = "7f5abfff-fae9-4c0d-8433-50f650583dac"
+	emailTestEmailID string = "61e5efb6-5da0-470f-a3ee-1109a2ea590e"
+)
+
+func EmailDataTest(t *testing.T, expectedData []map[string]any, data any) {
+	recorderResponseDataBytes, err := json.Marshal(data)
+
+	assert.Condition(t, func() bool {
+		return err == nil
+	}, "Failed to JSON Marshal for Recorder Response Data. Actual: %v", data)
+
+	var recorderResponseData []map[string]any
+
+	if err := json.Unmarshal(recorderResponseDataBytes, &recorderResponseData); err != nil {
+		var recorderResponseItem map[string]any
+
+		json.Unmarshal(recorderResponseDataBytes, &recorderResponseItem)
+
+		recorderResponseData = []map[string]any{recorderResponseItem}
+	}
+
+	assert.Len(t, recorderResponseData, len(expectedData))
+
+	for i, v := range recorderResponseData {
+		if i >= len(expectedData) {
+			break
+		}
+
+		assert.Condition(t, func() bool {
+			val, ok := v["id"].(string)
+
+			if !ok {
+				return false
+			}
+
+			emailID = val
+
+			_, err := uuid.Parse(val)
+
+			return err == nil
+		}, "Expected the ID in UUID form. Actual: %v", v["id"])
+
+		assert.Condition(t, func() bool {
+			val, ok := v["createdAt"].(string)
+
+			if !ok {
+				return false
+			}
+
+			_, err := time.Parse("2006-01-02T15:04:05.999999999Z07:00", val)
+
+			return err == nil
+		}, "Expected the Created At in datatime form (2006-01-02T15:04:05.999999999Z07:00). Actual: %v", v["createdAt"])
+
+		for key, value := range expectedData[i] {
+			assert.Equal(t, value, v[key], "Unexpected %s for email index %d", key, i)
+		}
+	}
+}
+
+func TestReadEmail(t *testing.T) {
+	var handlerFunc = func(c echo.Context) error {
+		return emailHandlerFunc.Read(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Read Email => Failed Validation => ID (isUUID)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/A/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "A",
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"id": "must be a valid UUID",
+					},
+				},
+			},
+		},
+		{
+			"Read Email => User Not Found",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/00000000-0000-4000-8000-000000000000/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "00000000-0000-4000-8000-000000000000",
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 404,
+				BodyPart: Response{
+					Code:        "0404",
+					Description: "NOT_FOUND",
+					Data: map[string]any{
+						"error": "USER_NOT_FOUND",
+					},
+				},
+			},
+		},
+		{
+			"Read Email => Success",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				if test.Expected.StatusCode == 200 {
+					EmailDataTest(t, []map[string]any{
+						{
+							"id":      emailTestEmailID,
+							"userId":  emailTestUserID,
+							"email":   "zelda.skyward@email.com",
+							"primary": true,
+						},
+					}, recorderResponse.Data)
+				} else {
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateEmail(t *testing.T) {
+	var handlerFunc = func(c echo.Context) error {
+		return emailHandlerFunc.Create(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Create Email => Failed Validation => Email (Required)",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+			nil,
+			map[string]any{},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"email": "cannot be blank",
+					},
+				},
+			},
+		},
+		{
+			"Create Email => Failed Validation => Email (isEmail)",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+			nil,
+			map[string]any{
+				"email": "A",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"email": "must be a valid email address",
+					},
+				},
+			},
+		},
+		{
+			"Create Email => User Not Found",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/00000000-0000-4000-8000-000000000000/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "00000000-0000-4000-8000-000000000000",
+				},
+			},
+			nil,
+			map[string]any{
+				"email": "zelda.link@gmail.com",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 404,
+				BodyPart: Response{
+					Code:        "0404",
+					Description: "NOT_FOUND",
+					Data: map[string]any{
+						"error": "USER_NOT_FOUND",
+					},
+				},
+			},
+		},
+		{
+			"Create Email => Email Already Exists",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+			nil,
+			map[string]any{
+				"email": "zelda.skyward@email.com",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 409,
+				BodyPart: Response{
+					Code:        "0409",
+					Description: "CONFLICT",
+					Data: map[string]any{
+						"error": "EMAIL_ALREADY_EXISTS",
+						"details": map[string]any{
+							"email": "zelda.skyward@email.com",
+						},
+					},
+				},
+			},
+		},
+		{
+			"Create Email => Email Already Taken",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+			nil,
+			map[string]any{
+				"email": "MrAndreID.Business@gmail.com",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 409,
+				BodyPart: Response{
+					Code:        "0409",
+					Description: "CONFLICT",
+					Data: map[string]any{
+						"error": "EMAIL_ALREADY_TAKEN",
+						"details": map[string]any{
+							"email": "mrandreid.business@gmail.com",
+						},
+					},
+				},
+			},
+		},
+		{
+			"Create Email => Success => Primary",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+			nil,
+			map[string]any{
+				"email":   "Zelda.Link@Gmail.com",
+				"primary": true,
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 201,
+				BodyPart: Response{
+					Code:        "0201",
+					Description: "CREATED",
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				if test.Expected.StatusCode == 201 {
+					EmailDataTest(t, []map[string]any{
+						{
+							"userId":  emailTestUserID,
+							"email":   "zelda.link@gmail.com",
+							"primary": true,
+						},
+					}, recorderResponse.Data)
+				} else {
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				}
+			}
+		})
+	}
+
+	t.Run("Create Email => Success => Previous Primary Unset", func(t *testing.T) {
+		c, recorder := PrepareContextFromTestCase(TestCase{
+			Request: Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+		})
+
+		createdEmailID := emailID
+
+		if assert.NoError(t, emailHandlerFunc.Read(c)) {
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			var recorderResponse Response
+			json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+			EmailDataTest(t, []map[string]any{
+				{
+					"id":      createdEmailID,
+					"primary": true,
+				},
+				{
+					"id":      emailTestEmailID,
+					"primary": false,
+				},
+			}, recorderResponse.Data)
+		}
+
+		emailID = createdEmailID
+	})
+}
+
+func TestDeleteEmail(t *testing.T) {
+	var handlerFunc = func(c echo.Context) error {
+		return emailHandlerFunc.Delete(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Delete Email => Failed Validation => Email ID (isUUID)",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails/A",
+				PathParams: []PathParam{
+					{
+						Name:  "id",
+						Value: emailTestUserID,
+					},
+					{
+						Name:  "emailId",
+						Value: "A",
+					},
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"emailId": "must be a valid UUID",
+					},
+				},
+			},
+		},
+		{
+			"Delete Email => Email Not Found",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails/00000000-0000-4000-8000-000000000000",
+				PathParams: []PathParam{
+					{
+						Name:  "id",
+						Value: emailTestUserID,
+					},
+					{
+						Name:  "emailId",
+						Value: "00000000-0000-4000-8000-000000000000",
+					},
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 404,
+				BodyPart: Response{
+					Code:        "0404",
+					Description: "NOT_FOUND",
+					Data: map[string]any{
+						"error": "EMAIL_NOT_FOUND",
+					},
+				},
+			},
+		},
+		{
+			"Delete Email => Success => Primary",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails/" + emailID,
+				PathParams: []PathParam{
+					{
+						Name:  "id",
+						Value: emailTestUserID,
+					},
+					{
+						Name:  "emailId",
+						Value: emailID,
+					},
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+		{
+			"Delete Email => Failed => Last Email",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails/" + emailTestEmailID,
+				PathParams: []PathParam{
+					{
+						Name:  "id",
+						Value: emailTestUserID,
+					},
+					{
+						Name:  "emailId",
+						Value: emailTestEmailID,
+					},
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "CANNOT_DELETE_LAST_EMAIL",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+			}
+		})
+	}
+
+	t.Run("Delete Email => Success => Primary Promoted", func(t *testing.T) {
+		c, recorder := PrepareContextFromTestCase(TestCase{
+			Request: Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: emailTestUserID,
+				},
+			},
+		})
+
+		if assert.NoError(t, emailHandlerFunc.Read(c)) {
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			var recorderResponse Response
+			json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+			EmailDataTest(t, []map[string]any{
+				{
+					"id":      emailTestEmailID,
+					"primary": true,
+				},
+			}, recorderResponse.Data)
+		}
+	})
+}
+
+func TestEmailPolicy(t *testing.T) {
+	headers := []Header{
+		{
+			Key:   "Content-Type",
+			Value: "application/json",
+		},
+	}
+
+	policyUserHandlerFunc := handlers.NewUserHandler(echo.New().Group(""), services.NewUserService(server.Container.UserRepository, services.UserServiceOptions{
+		BulkMaxItems: server.Application.Config.BulkMaxItems,
+		EmailPolicy: &emails.Policy{
+			GmailNormalization: true,
+			Allowlist:          map[string]struct{}{},
+			Blocklist:          map[string]struct{}{"example.org": {}},
+			Disposable:         map[string]struct{}{"mailinator.com": {}},
+		},
+	}), handlers.UserHandlerOptions{})
+
+	var handlerFunc = func(c echo.Context) error {
+		return policyUserHandlerFunc.Create(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Email Policy => Blocked and Disposable Domains",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user",
+			},
+			&headers,
+			types.CreateUserRequest{
+				Name: "Unit Test Policy",
+				Emails: []string{
+					"unit.test.policy@email.com",
+					"unit.test.policy@example.org",
+					"unit.test.policy@mailinator.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "INVALID_EMAIL",
+						"details": map[string]any{
+							"emails": map[string]any{
+								"1": "must not use a blocked domain",
+								"2": "must not use a disposable domain",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"Email Policy => Gmail Normalization => Duplicate Email",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user",
+			},
+			&headers,
+			types.CreateUserRequest{
+				Name: "Unit Test Policy",
+				Emails: []string{
+					"Unit.Test.Policy+work@gmail.com",
+					"unittestpolicy@googlemail.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "DUPLICATE_EMAIL",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+			}
+		})
+	}
+}
+
+type fakeNotifier struct {
+	Messages []notifiers.Message
+}
+
+func (n *fakeNotifier) Send(ctx context.Context, message notifiers.Message) error {
+	n.Messages = append(n.Messages, message)
+
+	return nil
+}
+
+func TestVerifyEmail(t *testing.T) {
+	var (
+		appKey   string        = "unit-test-app-key"
+		notifier *fakeNotifier = &fakeNotifier{}
+		address  string        = "zelda.verify." + runID + "@email.com"
+		token    string
+	)
+
+	verifyEmailHandlerFunc := handlers.NewEmailHandler(echo.New().Group(""), services.NewEmailService(server.Container.EmailRepository, services.EmailServiceOptions{
+		Notifier:        notifier,
+		AppKey:          appKey,
+		VerificationTTL: time.Hour,
+	}), handlers.EmailHandlerOptions{})
+
+	c, recorder := PrepareContextFromTestCase(TestCase{
+		Request: Request{
+			Method: http.MethodPost,
+			Url:    "/api/v1/user/" + emailTestUserID + "/emails",
+			PathParam: &PathParam{
+				Name:  "id",
+				Value: emailTestUserID,
+			},
+		},
+		RequestBody: map[string]any{
+			"email": address,
+		},
+	})
+
+	if assert.NoError(t, verifyEmailHandlerFunc.Create(c)) {
+		assert.Equal(t, http.StatusCreated, recorder.Code)
+
+		var recorderResponse Response
+		json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+		EmailDataTest(t, []map[string]any{
+			{
+				"email":      address,
+				"primary":    false,
+				"verifiedAt": nil,
+			},
+		}, recorderResponse.Data)
+	}
+
+	if assert.Len(t, notifier.Messages, 1) {
+		assert.Equal(t, address, notifier.Messages[0].To)
+
+		token = notifier.Messages[0].Body[strings.LastIndex(notifier.Messages[0].Body, "\n")+1:]
+	}
+
+	expiredToken, _ := emails.SignToken(appKey, emails.Token{
+		EmailID:   emailID,
+		Email:     address,
+		ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+	})
+
+	otherToken, _ := emails.SignToken(appKey, emails.Token{
+		EmailID:   emailTestEmailID,
+		Email:     "zelda.skyward@email.com",
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	})
+
+	pathParams := []PathParam{
+		{
+			Name:  "id",
+			Value: emailTestUserID,
+		},
+		{
+			Name:  "emailId",
+			Value: emailID,
+		},
+	}
+
+	var handlerFunc = func(c echo.Context) error {
+		return verifyEmailHandlerFunc.Verify(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Verify Email => Failed Validation => Token (Required)",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify",
+				PathParams: pathParams,
+			},
+			nil,
+			map[string]any{},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"token": "cannot be blank",
+					},
+				},
+			},
+		},
+		{
+			"Verify Email => Invalid Token",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify",
+				PathParams: pathParams,
+			},
+			nil,
+			map[string]any{
+				"token": token + "A",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "INVALID_VERIFICATION_TOKEN",
+					},
+				},
+			},
+		},
+		{
+			"Verify Email => Token for Another Email",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify",
+				PathParams: pathParams,
+			},
+			nil,
+			map[string]any{
+				"token": otherToken,
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "INVALID_VERIFICATION_TOKEN",
+					},
+				},
+			},
+		},
+		{
+			"Verify Email => Expired Token",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify",
+				PathParams: pathParams,
+			},
+			nil,
+			map[string]any{
+				"token": expiredToken,
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "VERIFICATION_TOKEN_EXPIRED",
+					},
+				},
+			},
+		},
+		{
+			"Verify Email => Resend",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify/resend",
+				PathParams: pathParams,
+			},
+			nil,
+			nil,
+			func(c echo.Context) error {
+				return verifyEmailHandlerFunc.ResendVerification(c)
+			},
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+		{
+			"Verify Email => Success",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify",
+				PathParams: pathParams,
+			},
+			nil,
+			map[string]any{
+				"token": token,
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+		{
+			"Verify Email => Resend => Already Verified",
+			Request{
+				Method:     http.MethodPost,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID + "/verify/resend",
+				PathParams: pathParams,
+			},
+			nil,
+			nil,
+			func(c echo.Context) error {
+				return verifyEmailHandlerFunc.ResendVerification(c)
+			},
+			ExpectedResponse{
+				StatusCode: 409,
+				BodyPart: Response{
+					Code:        "0409",
+					Description: "CONFLICT",
+					Data: map[string]any{
+						"error": "EMAIL_ALREADY_VERIFIED",
+					},
+				},
+			},
+		},
+		{
+			"Verify Email => Delete Verified Email",
+			Request{
+				Method:     http.MethodDelete,
+				Url:        "/api/v1/user/" + emailTestUserID + "/emails/" + emailID,
+				PathParams: pathParams,
+			},
+			nil,
+			nil,
+			func(c echo.Context) error {
+				return verifyEmailHandlerFunc.Delete(c)
+			},
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				if test.Expected.BodyPart.Data != nil || recorderResponse.Data == nil {
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				} else {
+					assert.Condition(t, func() bool {
+						data, ok := recorderResponse.Data.(map[string]any)
+
+						return ok && data["verifiedAt"] != nil
+					}, "Expected the Verified At to be set. Actual: %v", recorderResponse.Data)
+				}
+			}
+		})
+	}
+
+	assert.Len(t, notifier.Messages, 2)
+}
+
+func TestVerifyEmailOnUserChanges(t *testing.T) {
+	var (
+		notifier *fakeNotifier = &fakeNotifier{}
+		first    string        = "unit.test.keep." + runID + "@email.com"
+		second   string        = "unit.test.drop." + runID + "@email.com"
+		third    string        = "unit.test.add." + runID + "@email.com"
+	)
+
+	userService := services.NewUserService(server.Container.UserRepository, services.UserServiceOptions{
+		BulkMaxItems:    server.Application.Config.BulkMaxItems,
+		Notifier:        notifier,
+		AppKey:          "unit-test-app-key",
+		VerificationTTL: time.Hour,
+	})
+
+	user, err := userService.Create(types.CreateUserRequest{
+		Name:   "Unit Test Verify",
+		Emails: []string{first, second},
+	})
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	defer userService.Delete(types.DeleteUserRequest{ID: user.ID})
+
+	assert.Len(t, notifier.Messages, 2)
+
+	_, err = server.Container.EmailRepository.Verify(context.Background(), user.ID, user.Emails[0].ID)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	user, err = userService.Update(types.UpdateUserRequest{
+		ID:     user.ID,
+		Name:   "Unit Test Verify Update",
+		Emails: []string{first, third},
+	})
+
+	if !assert.NoError(t, err) || !assert.Len(t, user.Emails, 2) {
+		return
+	}
+
+	assert.Equal(t, first, user.Emails[0].Email)
+
+	assert.NotNil(t, user.Emails[0].VerifiedAt)
+
+	assert.Equal(t, third, user.Emails[1].Email)
+
+	if assert.Len(t, notifier.Messages, 3) {
+		assert.Equal(t, third, notifier.Messages[2].To)
+	}
+
+	userEmails, err := server.Container.EmailRepository.Read(context.Background(), user.ID)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, userEmails, 2)
+	}
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/MrAndreID/goapi/internal/handlers"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	healthHandlerFunc := handlers.NewHealthHandler(echo.New(), server.Container.HealthService)
+
+	cases := []TestCase{
+		{
+			"Health => Liveness",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/healthz",
+			},
+			nil,
+			nil,
+			healthHandlerFunc.Live,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+		{
+			"Health => Readiness",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/readyz",
+			},
+			nil,
+			nil,
+			healthHandlerFunc.Ready,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				assert.Condition(t, func() bool {
+					data, ok := recorderResponse.Data.(map[string]any)
+
+					if !ok {
+						return false
+					}
+
+					return data["status"] == "UP"
+				}, "Expected the Status is UP. Actual: %v", recorderResponse.Data)
+			}
+		})
+	}
+}
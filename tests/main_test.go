@@ -11,9 +11,10 @@ import (
 
 type (
 	Request struct {
-		Method    string
-		Url       string
-		PathParam *PathParam
+		Method     string
+		Url        string
+		PathParam  *PathParam
+		PathParams []PathParam
 	}
 
 	PathParam struct {
@@ -79,5 +80,18 @@ func PrepareContextFromTestCase(test TestCase) (c echo.Context, recorder *httpte
 		c.SetParamValues(test.Request.PathParam.Value)
 	}
 
+	if len(test.Request.PathParams) != 0 {
+		var names, values []string
+
+		for _, v := range test.Request.PathParams {
+			names = append(names, v.Name)
+			values = append(values, v.Value)
+		}
+
+		c.SetParamNames(names...)
+
+		c.SetParamValues(values...)
+	}
+
 	return c, recorder
 }
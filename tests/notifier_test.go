@@ -0,0 +1,263 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MrAndreID/goapi/notifiers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSMTPSession struct {
+	Auth string
+	From string
+	To   []string
+	Data string
+}
+
+func startFakeSMTPServer(t *testing.T) (string, <-chan fakeSMTPSession) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("failed to listen fake smtp server: %v", err)
+	}
+
+	sessions := make(chan fakeSMTPSession, 1)
+
+	t.Cleanup(func() {
+		listener.Close()
+	})
+
+	go func() {
+		conn, err := listener.Accept()
+
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		var (
+			session fakeSMTPSession
+			text    *textproto.Conn = textproto.NewConn(conn)
+		)
+
+		text.PrintfLine("220 localhost ESMTP")
+
+		for {
+			line, err := text.ReadLine()
+
+			if err != nil {
+				return
+			}
+
+			command := strings.ToUpper(line)
+
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				text.PrintfLine("250-localhost")
+				text.PrintfLine("250 AUTH PLAIN")
+			case strings.HasPrefix(command, "AUTH PLAIN"):
+				session.Auth = strings.TrimSpace(line[len("AUTH PLAIN"):])
+
+				text.PrintfLine("235 2.7.0 Authentication successful")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				session.From = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+
+				text.PrintfLine("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				session.To = append(session.To, strings.Trim(line[len("RCPT TO:"):], "<> "))
+
+				text.PrintfLine("250 OK")
+			case command == "DATA":
+				text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+
+				data, err := text.ReadDotBytes()
+
+				if err != nil {
+					return
+				}
+
+				session.Data = string(data)
+
+				text.PrintfLine("250 OK")
+			case command == "QUIT":
+				text.PrintfLine("221 Bye")
+
+				sessions <- session
+
+				return
+			default:
+				text.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), sessions
+}
+
+type blockingNotifier struct {
+	release chan struct{}
+	sent    chan notifiers.Message
+}
+
+func (n *blockingNotifier) Send(ctx context.Context, message notifiers.Message) error {
+	<-n.release
+
+	n.sent <- message
+
+	return nil
+}
+
+func TestNotifier(t *testing.T) {
+	t.Run("Notifier => Connection Not Found", func(t *testing.T) {
+		_, err := notifiers.New(&notifiers.Notifier{Connection: "A"})
+
+		assert.EqualError(t, err, "Notifier Connection Not Found")
+	})
+
+	t.Run("Notifier => Log", func(t *testing.T) {
+		notifier, err := notifiers.New(&notifiers.Notifier{})
+
+		if assert.NoError(t, err) {
+			assert.NoError(t, notifier.Send(context.Background(), notifiers.Message{
+				To:      "unit.test@email.com",
+				Subject: "Unit Test",
+				Body:    "Unit Test",
+			}))
+		}
+	})
+
+	t.Run("Notifier => Queue", func(t *testing.T) {
+		notifier := &blockingNotifier{
+			release: make(chan struct{}),
+			sent:    make(chan notifiers.Message, 3),
+		}
+
+		queue := notifiers.NewQueueNotifier(notifier, 1, 1)
+
+		message := notifiers.Message{
+			To:      "unit.test@email.com",
+			Subject: "Unit Test",
+			Body:    "Unit Test",
+		}
+
+		assert.NoError(t, queue.Send(context.Background(), message))
+
+		assert.Eventually(t, func() bool {
+			return queue.Send(context.Background(), message) == nil
+		}, time.Second, 10*time.Millisecond)
+
+		assert.ErrorIs(t, queue.Send(context.Background(), message), notifiers.ErrQueueFull)
+
+		close(notifier.release)
+
+		queue.Close()
+
+		assert.Len(t, notifier.sent, 2)
+
+		assert.ErrorIs(t, queue.Send(context.Background(), message), notifiers.ErrQueueClosed)
+	})
+
+	t.Run("Notifier => SMTP Timeout", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		defer listener.Close()
+
+		go func() {
+			conn, err := listener.Accept()
+
+			if err == nil {
+				defer conn.Close()
+
+				time.Sleep(2 * time.Second)
+			}
+		}()
+
+		host, port, _ := net.SplitHostPort(listener.Addr().String())
+
+		notifier, err := notifiers.New(&notifiers.Notifier{
+			Connection: "smtp",
+			Host:       host,
+			Port:       port,
+			From:       "no-reply@email.com",
+			Timeout:    100 * time.Millisecond,
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		start := time.Now()
+
+		assert.Error(t, notifier.Send(context.Background(), notifiers.Message{
+			To:      "unit.test@email.com",
+			Subject: "Unit Test",
+			Body:    "Unit Test",
+		}))
+
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Notifier => SMTP", func(t *testing.T) {
+		address, sessions := startFakeSMTPServer(t)
+
+		host, port, _ := net.SplitHostPort(address)
+
+		notifier, err := notifiers.New(&notifiers.Notifier{
+			Connection: "smtp",
+			Host:       host,
+			Port:       port,
+			Username:   "unit-test",
+			Password:   "secret",
+			From:       "no-reply@email.com",
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+
+		defer cancel()
+
+		err = notifier.Send(ctx, notifiers.Message{
+			To:      "unit.test@email.com",
+			Subject: "Verify Your Email Address\r\nBcc: injected@email.com",
+			Body:    "Line 1\nLine 2",
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		select {
+		case session := <-sessions:
+			auth, _ := base64.StdEncoding.DecodeString(session.Auth)
+
+			assert.Equal(t, "\x00unit-test\x00secret", string(auth))
+
+			assert.Equal(t, "no-reply@email.com", session.From)
+
+			assert.Equal(t, []string{"unit.test@email.com"}, session.To)
+
+			assert.Contains(t, session.Data, "To: unit.test@email.com\n")
+
+			assert.Contains(t, session.Data, "Subject: Verify Your Email AddressBcc: injected@email.com\n")
+
+			assert.Contains(t, session.Data, "\nLine 1\nLine 2")
+		case <-ctx.Done():
+			t.Fatal("fake smtp server did not receive the message")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MrAndreID/goapi/retries"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetries(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("Retries => Success After Retrying", func(t *testing.T) {
+		var calls int
+
+		err := retries.Do(context.Background(), retries.Policy{MaxAttempts: 5, InitialDelay: time.Millisecond}, "unit-test", func() error {
+			calls++
+
+			if calls < 3 {
+				return errFailed
+			}
+
+			return nil
+		})
+
+		assert.NoError(t, err)
+
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Retries => Max Attempts", func(t *testing.T) {
+		var calls int
+
+		err := retries.Do(context.Background(), retries.Policy{MaxAttempts: 4, InitialDelay: time.Millisecond}, "unit-test", func() error {
+			calls++
+
+			return errFailed
+		})
+
+		assert.ErrorIs(t, err, errFailed)
+
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("Retries => At Least One Attempt", func(t *testing.T) {
+		var calls int
+
+		err := retries.Do(context.Background(), retries.Policy{}, "unit-test", func() error {
+			calls++
+
+			return errFailed
+		})
+
+		assert.ErrorIs(t, err, errFailed)
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Retries => Permanent", func(t *testing.T) {
+		var calls int
+
+		err := retries.Do(context.Background(), retries.Policy{MaxAttempts: 5, InitialDelay: time.Millisecond}, "unit-test", func() error {
+			calls++
+
+			return retries.Permanent(errFailed)
+		})
+
+		assert.Equal(t, errFailed, err)
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Retries => Context Cancelled", func(t *testing.T) {
+		var calls int
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		start := time.Now()
+
+		err := retries.Do(ctx, retries.Policy{MaxAttempts: 5, InitialDelay: time.Minute}, "unit-test", func() error {
+			calls++
+
+			cancel()
+
+			return errFailed
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+
+		assert.Equal(t, 1, calls)
+
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Retries => Backoff", func(t *testing.T) {
+		policy := retries.Policy{InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+
+		assert.Equal(t, time.Second, policy.Delay(1))
+
+		assert.Equal(t, 2*time.Second, policy.Delay(2))
+
+		assert.Equal(t, 4*time.Second, policy.Delay(3))
+
+		assert.Equal(t, 5*time.Second, policy.Delay(4))
+
+		assert.Equal(t, 5*time.Second, policy.Delay(10))
+	})
+
+	t.Run("Retries => Jitter Bounds", func(t *testing.T) {
+		policy := retries.Policy{InitialDelay: time.Second, MaxDelay: 4 * time.Second, Jitter: 0.2}
+
+		for attempt := 1; attempt <= 5; attempt++ {
+			base := min(time.Second<<(attempt-1), 4*time.Second)
+
+			for range 100 {
+				delay := policy.Delay(attempt)
+
+				assert.GreaterOrEqual(t, delay, base-base/5)
+
+				assert.LessOrEqual(t, delay, base+base/5)
+			}
+		}
+	})
+}
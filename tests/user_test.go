@@ -1,14 +1,24 @@
 package tests
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"maps"
+	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/MrAndreID/goapi/applications"
+	"github.com/MrAndreID/goapi/databases/models"
 	"github.com/MrAndreID/goapi/internal/handlers"
+	"github.com/MrAndreID/goapi/internal/repositories"
 	"github.com/MrAndreID/goapi/internal/types"
 
 	"github.com/google/uuid"
@@ -18,7 +28,11 @@ import (
 
 var id string
 
-var userHandlerFunc = handlers.NewUserHandler(applications.Start(false).(*echo.Group), applications.UserService)
+var runID = strconv.FormatInt(time.Now().UnixNano(), 36)
+
+var server, _ = applications.Start(false)
+
+var userHandlerFunc = handlers.NewUserHandler(server.Group, server.Container.UserService, handlers.UserHandlerOptions{})
 
 func UserDataTest(t *testing.T, expectedData, data any) {
 	recorderResponseDataBytes, err := json.Marshal(data)
@@ -520,10 +534,42 @@ func TestCreateUser(t *testing.T) {
 			},
 			handlerFunc,
 			ExpectedResponse{
-				StatusCode: 500,
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "DUPLICATE_EMAIL",
+					},
+				},
+			},
+		},
+		{
+			"Create User => Email Already Taken",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user",
+			},
+			&headers,
+			types.CreateUserRequest{
+				Name: "Unit Test",
+				Emails: []string{
+					"unit_test0@email.com",
+					"MrAndreID.Business@gmail.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 409,
 				BodyPart: Response{
-					Code:        "0500",
-					Description: "INTERNAL_SERVER_ERROR",
+					Code:        "0409",
+					Description: "CONFLICT",
+					Data: map[string]any{
+						"error": "EMAIL_ALREADY_TAKEN",
+						"details": map[string]any{
+							"email": "mrandreid.business@gmail.com",
+						},
+					},
 				},
 			},
 		},
@@ -676,6 +722,46 @@ func TestReadUser(t *testing.T) {
 				},
 			},
 		},
+		{
+			"Read User => Failed Validation => Sort (Whitelist)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?sort=-password",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"sort": "the password is not a sortable field",
+					},
+				},
+			},
+		},
+		{
+			"Read User => Failed Validation => Sort (Duplicate)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?sort=name,-name",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"sort": "the name is sorted more than once",
+					},
+				},
+			},
+		},
 		{
 			"Read User => Failed Validation => Search (Blacklist => ')",
 			Request{
@@ -836,6 +922,26 @@ func TestReadUser(t *testing.T) {
 				},
 			},
 		},
+		{
+			"Read User => Failed Validation => Search Fields (Whitelist)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?searchFields=name,password",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"searchFields": "the password is not a searchable field",
+					},
+				},
+			},
+		},
 		{
 			"Read User => Failed Validation => Disable Calculate Total (In)",
 			Request{
@@ -857,10 +963,10 @@ func TestReadUser(t *testing.T) {
 			},
 		},
 		{
-			"Read User => Failed Validation => ID (isUUID)",
+			"Read User => Failed Validation => Cursor (In)",
 			Request{
 				Method: http.MethodGet,
-				Url:    "/api/v1/user?id=A",
+				Url:    "/api/v1/user?cursor=A",
 			},
 			nil,
 			nil,
@@ -871,138 +977,59 @@ func TestReadUser(t *testing.T) {
 					Code:        "0400",
 					Description: "BAD_REQUEST",
 					Data: map[string]any{
-						"id": "must be a valid UUID",
+						"cursor": "must be a valid value",
 					},
 				},
 			},
 		},
 		{
-			"Read User => Success => Without Query Param",
+			"Read User => Failed Validation => After (Cursor)",
 			Request{
 				Method: http.MethodGet,
-				Url:    "/api/v1/user",
+				Url:    "/api/v1/user?after=A",
 			},
 			nil,
 			nil,
 			handlerFunc,
 			ExpectedResponse{
-				StatusCode: 200,
+				StatusCode: 400,
 				BodyPart: Response{
-					Code:        "0200",
-					Description: "SUCCESS",
-					Data:        map[string]any{},
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"after": "the after is not a valid cursor",
+					},
 				},
 			},
 		},
 		{
-			"Read User => Success",
+			"Read User => Failed Validation => Before (Empty)",
 			Request{
 				Method: http.MethodGet,
-				Url:    "/api/v1/user?page=1&limit=10&orderBy=name&sortBy=asc&search=Unit&disableCalculateTotal=true",
+				Url:    "/api/v1/user?after=eyJrIjpbIm5hbWUiLCJpZCJdLCJ2IjpbIkEiLCJCIl19&before=eyJrIjpbIm5hbWUiLCJpZCJdLCJ2IjpbIkEiLCJCIl19",
 			},
 			nil,
 			nil,
 			handlerFunc,
 			ExpectedResponse{
-				StatusCode: 200,
+				StatusCode: 400,
 				BodyPart: Response{
-					Code:        "0200",
-					Description: "SUCCESS",
-					Data:        map[string]any{},
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"before": "cannot be used together with after",
+					},
 				},
 			},
 		},
-	}
-
-	for _, test := range cases {
-		t.Run(test.TestName, func(t *testing.T) {
-			c, recorder := PrepareContextFromTestCase(test)
-
-			if assert.NoError(t, test.HandlerFunc(c)) {
-				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
-
-				var recorderResponse Response
-				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
-
-				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
-
-				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
-
-				if test.Expected.StatusCode != 200 {
-					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
-				} else {
-					recorderResponseDataBytes, err := json.Marshal(recorderResponse.Data)
-
-					assert.Condition(t, func() bool {
-						return err == nil
-					}, "Failed to JSON Marshal for Recorder Response Data. Actual: %v", recorderResponse.Data)
-
-					var recorderResponseData map[string]any
-
-					json.Unmarshal(recorderResponseDataBytes, &recorderResponseData)
-
-					assert.Condition(t, func() bool {
-						return len(recorderResponseData["data"].([]any)) != 0
-					}, "Expected the Data more than Zero. Actual: %v", len(recorderResponseData["data"].([]any)))
-
-					dataSliceBytes, err := json.Marshal(recorderResponseData["data"])
-
-					assert.Condition(t, func() bool {
-						return err == nil
-					}, "Failed to JSON Marshal for Recorder Response Data => Data. Actual: %v", recorderResponseData["data"])
-
-					var dataSlice []map[string]any
-
-					json.Unmarshal(dataSliceBytes, &dataSlice)
-
-					for _, v := range dataSlice {
-						UserDataTest(t, test.Expected.BodyPart.Data, v)
-					}
-
-					assert.Condition(t, func() bool {
-						_, ok := recorderResponseData["total"].(float64)
-
-						return ok
-					}, "Expected the Total in Float64 form. Actual: %T", recorderResponseData["total"])
-
-					assert.Condition(t, func() bool {
-						_, ok := recorderResponseData["nextPage"].(bool)
-
-						return ok
-					}, "Expected the Next Page in Boolean form. Actual: %T", recorderResponseData["nextPage"])
-				}
-			}
-		})
-	}
-}
-
-func TestUpdateUser(t *testing.T) {
-	headers := []Header{
-		{
-			Key:   "Content-Type",
-			Value: "application/json",
-		},
-	}
-
-	var handlerFunc = func(c echo.Context) error {
-		return userHandlerFunc.Update(c)
-	}
-
-	cases := []TestCase{
 		{
-			"Update User => Failed Validation => ID (Required)",
+			"Read User => Failed Validation => Filter (Field)",
 			Request{
-				Method: http.MethodPatch,
-				Url:    "/api/v1/user",
-			},
-			&headers,
-			types.UpdateUserRequest{
-				Name: "Unit Test Update",
-				Emails: []string{
-					"unit_test0_update@email.com",
-					"unit_test1_update@email.com",
-				},
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?filter[password][eq]=A",
 			},
+			nil,
+			nil,
 			handlerFunc,
 			ExpectedResponse{
 				StatusCode: 400,
@@ -1010,29 +1037,21 @@ func TestUpdateUser(t *testing.T) {
 					Code:        "0400",
 					Description: "BAD_REQUEST",
 					Data: map[string]any{
-						"id": "cannot be blank",
+						"filter": map[string]any{
+							"password": "the password is not a filterable field",
+						},
 					},
 				},
 			},
 		},
 		{
-			"Update User => Failed Validation => ID (isUUID)",
+			"Read User => Failed Validation => Filter (Operator)",
 			Request{
-				Method: http.MethodPatch,
-				Url:    "/api/v1/user/A",
-				PathParam: &PathParam{
-					Name:  "id",
-					Value: "A",
-				},
-			},
-			&headers,
-			types.UpdateUserRequest{
-				Name: "Unit Test Update",
-				Emails: []string{
-					"unit_test0_update@email.com",
-					"unit_test1_update@email.com",
-				},
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?filter[name][gt]=A",
 			},
+			nil,
+			nil,
 			handlerFunc,
 			ExpectedResponse{
 				StatusCode: 400,
@@ -1040,29 +1059,21 @@ func TestUpdateUser(t *testing.T) {
 					Code:        "0400",
 					Description: "BAD_REQUEST",
 					Data: map[string]any{
-						"id": "must be a valid UUID",
+						"filter": map[string]any{
+							"name": "the gt is not an allowed operator for name",
+						},
 					},
 				},
 			},
 		},
 		{
-			"Update User => Failed Validation => Name (Blacklist => ')",
+			"Read User => Failed Validation => Filter (Datetime)",
 			Request{
-				Method: http.MethodPatch,
-				Url:    "/api/v1/user/" + id,
-				PathParam: &PathParam{
-					Name:  "id",
-					Value: id,
-				},
-			},
-			&headers,
-			types.UpdateUserRequest{
-				Name: "Unit Test Update'",
-				Emails: []string{
-					"unit_test0_update@email.com",
-					"unit_test1_update@email.com",
-				},
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?filter[createdAt][gte]=A",
 			},
+			nil,
+			nil,
 			handlerFunc,
 			ExpectedResponse{
 				StatusCode: 400,
@@ -1070,29 +1081,21 @@ func TestUpdateUser(t *testing.T) {
 					Code:        "0400",
 					Description: "BAD_REQUEST",
 					Data: map[string]any{
-						"name": "the name contains unsafe characters",
+						"filter": map[string]any{
+							"createdAt": "the createdAt is not a datetime format",
+						},
 					},
 				},
 			},
 		},
 		{
-			`Update User => Failed Validation => Name (Blacklist => ")`,
+			"Read User => Failed Validation => ID (isUUID)",
 			Request{
-				Method: http.MethodPatch,
-				Url:    "/api/v1/user/" + id,
-				PathParam: &PathParam{
-					Name:  "id",
-					Value: id,
-				},
-			},
-			&headers,
-			types.UpdateUserRequest{
-				Name: `Unit Test Update"`,
-				Emails: []string{
-					"unit_test0_update@email.com",
-					"unit_test1_update@email.com",
-				},
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?id=A",
 			},
+			nil,
+			nil,
 			handlerFunc,
 			ExpectedResponse{
 				StatusCode: 400,
@@ -1100,7 +1103,1389 @@ func TestUpdateUser(t *testing.T) {
 					Code:        "0400",
 					Description: "BAD_REQUEST",
 					Data: map[string]any{
-						"name": "the name contains unsafe characters",
+						"id": "must be a valid UUID",
+					},
+				},
+			},
+		},
+		{
+			"Read User => Failed Validation => Fields (Whitelist)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?fields=id,password",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"fields": "the password is not allowed for fields",
+					},
+				},
+			},
+		},
+		{
+			"Read User => Failed Validation => Include (Whitelist)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?include=phones",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"include": "the phones is not allowed for include",
+					},
+				},
+			},
+		},
+		{
+			"Read User => Success => Without Query Param",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+		{
+			"Read User => Success => Cursor",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?cursor=true&limit=10&orderBy=name&sortBy=asc",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+		{
+			"Read User => Success => Filter",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?filter[name][like]=Unit&filter[createdAt][gte]=2020-01-01%2000:00:00",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+		{
+			"Read User => Success => Search By Email",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?search=@&searchFields=emails.email",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+		{
+			"Read User => Success => Sparse Fieldsets",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?fields=id,name&include=emails",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+		{
+			"Read User => Success => Multi Sort",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?sort=-createdAt,name",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+		{
+			"Read User => Success",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user?page=1&limit=10&orderBy=name&sortBy=asc&search=Unit&disableCalculateTotal=true",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data:        map[string]any{},
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				if test.Expected.StatusCode != 200 {
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				} else {
+					recorderResponseDataBytes, err := json.Marshal(recorderResponse.Data)
+
+					assert.Condition(t, func() bool {
+						return err == nil
+					}, "Failed to JSON Marshal for Recorder Response Data. Actual: %v", recorderResponse.Data)
+
+					var recorderResponseData map[string]any
+
+					json.Unmarshal(recorderResponseDataBytes, &recorderResponseData)
+
+					assert.Condition(t, func() bool {
+						return len(recorderResponseData["data"].([]any)) != 0
+					}, "Expected the Data more than Zero. Actual: %v", len(recorderResponseData["data"].([]any)))
+
+					dataSliceBytes, err := json.Marshal(recorderResponseData["data"])
+
+					assert.Condition(t, func() bool {
+						return err == nil
+					}, "Failed to JSON Marshal for Recorder Response Data => Data. Actual: %v", recorderResponseData["data"])
+
+					var dataSlice []map[string]any
+
+					json.Unmarshal(dataSliceBytes, &dataSlice)
+
+					for _, v := range dataSlice {
+						UserDataTest(t, test.Expected.BodyPart.Data, v)
+					}
+
+					assert.Condition(t, func() bool {
+						_, ok := recorderResponseData["total"].(float64)
+
+						return ok
+					}, "Expected the Total in Float64 form. Actual: %T", recorderResponseData["total"])
+
+					assert.Condition(t, func() bool {
+						_, ok := recorderResponseData["nextPage"].(bool)
+
+						return ok
+					}, "Expected the Next Page in Boolean form. Actual: %T", recorderResponseData["nextPage"])
+				}
+			}
+		})
+	}
+}
+
+func TestReadUserVersionField(t *testing.T) {
+	c, recorder := PrepareContextFromTestCase(TestCase{
+		Request: Request{
+			Method: http.MethodGet,
+			Url:    "/api/v1/user?fields=version&cursor=true",
+		},
+	})
+
+	if !assert.NoError(t, userHandlerFunc.Read(c)) || !assert.Equal(t, 200, recorder.Code) {
+		return
+	}
+
+	var recorderResponse struct {
+		Data struct {
+			Data []map[string]any `json:"data"`
+		} `json:"data"`
+	}
+
+	json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+	if !assert.NotEmpty(t, recorderResponse.Data.Data) {
+		return
+	}
+
+	for _, record := range recorderResponse.Data.Data {
+		assert.ElementsMatch(t, []string{"version"}, slices.Collect(maps.Keys(record)))
+
+		assert.Condition(t, func() bool {
+			version, ok := record["version"].(float64)
+
+			return ok && version >= 1
+		}, "Expected the Version is a Positive Number. Actual: %v", record["version"])
+	}
+}
+
+func TestFindUser(t *testing.T) {
+	var handlerFunc = func(c echo.Context) error {
+		return userHandlerFunc.Find(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Find User => Failed Validation => ID (isUUID)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/A",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "A",
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"id": "must be a valid UUID",
+					},
+				},
+			},
+		},
+		{
+			"Find User => Not Found",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/00000000-0000-4000-8000-000000000000",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "00000000-0000-4000-8000-000000000000",
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 404,
+				BodyPart: Response{
+					Code:        "0404",
+					Description: "NOT_FOUND",
+					Data: map[string]any{
+						"error": "USER_NOT_FOUND",
+					},
+				},
+			},
+		},
+		{
+			"Find User => Success",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/" + id,
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: id,
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data: map[string]any{
+						"deletedAt": nil,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				if test.Expected.StatusCode != 200 {
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				} else {
+					UserDataTest(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+
+					assert.Regexp(t, `^"[0-9]+"$`, recorder.Header().Get("ETag"))
+				}
+			}
+		})
+	}
+}
+
+func TestConditionalFindUser(t *testing.T) {
+	var handlerFunc = handlers.CacheControl(handlers.CachePolicy{
+		CacheControl: "private, no-cache",
+	})(userHandlerFunc.Find)
+
+	request := Request{
+		Method: http.MethodGet,
+		Url:    "/api/v1/user/" + id,
+		PathParam: &PathParam{
+			Name:  "id",
+			Value: id,
+		},
+	}
+
+	c, recorder := PrepareContextFromTestCase(TestCase{
+		Request: request,
+	})
+
+	if !assert.NoError(t, handlerFunc(c)) || !assert.Equal(t, 200, recorder.Code) {
+		return
+	}
+
+	assert.Equal(t, "private, no-cache", recorder.Header().Get(echo.HeaderCacheControl))
+
+	etag, modifiedAt := recorder.Header().Get("ETag"), recorder.Header().Get(echo.HeaderLastModified)
+
+	cases := []TestCase{
+		{
+			"Conditional Find User => If-None-Match (Not Modified)",
+			request,
+			&[]Header{
+				{
+					Key:   "If-None-Match",
+					Value: etag,
+				},
+			},
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 304,
+			},
+		},
+		{
+			"Conditional Find User => If-None-Match (Modified)",
+			request,
+			&[]Header{
+				{
+					Key:   "If-None-Match",
+					Value: `W/"0"`,
+				},
+			},
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+			},
+		},
+		{
+			"Conditional Find User => If-Modified-Since (Not Modified)",
+			request,
+			&[]Header{
+				{
+					Key:   echo.HeaderIfModifiedSince,
+					Value: modifiedAt,
+				},
+			},
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 304,
+			},
+		},
+		{
+			"Conditional Find User => If-Modified-Since (Modified)",
+			request,
+			&[]Header{
+				{
+					Key:   echo.HeaderIfModifiedSince,
+					Value: "Mon, 01 Jan 2001 00:00:00 GMT",
+				},
+			},
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				assert.Equal(t, etag, recorder.Header().Get("ETag"))
+
+				if test.Expected.StatusCode == 304 {
+					assert.Empty(t, recorder.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestUserRouteMiddlewares(t *testing.T) {
+	e := echo.New()
+
+	handlers.NewUserHandler(e.Group("/api/v1"), server.Container.UserService, handlers.UserHandlerOptions{
+		Middlewares: []echo.MiddlewareFunc{
+			func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					return c.NoContent(http.StatusServiceUnavailable)
+				}
+			},
+		},
+	})
+
+	cases := []struct {
+		TestName   string
+		Method     string
+		Url        string
+		StatusCode int
+	}{
+		{"User Route Middlewares => Read", http.MethodGet, "/api/v1/user", 503},
+		{"User Route Middlewares => Find", http.MethodGet, "/api/v1/user/" + id, 503},
+		{"User Route Middlewares => Update", http.MethodPatch, "/api/v1/user/" + id, 503},
+		{"User Route Middlewares => Unmatched Route", http.MethodGet, "/api/v1/unknown", 404},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+
+			e.ServeHTTP(recorder, httptest.NewRequest(test.Method, test.Url, nil))
+
+			assert.Equal(t, test.StatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestExportUser(t *testing.T) {
+	var handlerFunc = func(c echo.Context) error {
+		return userHandlerFunc.Export(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Export User => Failed Validation => Format (Required)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/export",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"format": "cannot be blank",
+					},
+				},
+			},
+		},
+		{
+			"Export User => Failed Validation => Format (In)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/export?format=pdf",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"format": "must be a valid value",
+					},
+				},
+			},
+		},
+		{
+			"Export User => Failed Validation => Sort (Whitelist)",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/export?format=csv&sort=-password",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"sort": "the password is not a sortable field",
+					},
+				},
+			},
+		},
+		{
+			"Export User => Success => CSV",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/export?format=csv&sort=-createdAt,name",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+			},
+		},
+		{
+			"Export User => Success => XLSX",
+			Request{
+				Method: http.MethodGet,
+				Url:    "/api/v1/user/export?format=xlsx&search=Unit",
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 200,
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				if test.Expected.StatusCode != 200 {
+					var recorderResponse Response
+					json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+					assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+					assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				} else {
+					assert.True(t, strings.HasPrefix(recorder.Header().Get(echo.HeaderContentDisposition), `attachment; filename="users-`))
+
+					if strings.Contains(test.Request.Url, "format=csv") {
+						assert.True(t, strings.HasPrefix(recorder.Body.String(), "id,name,emails,createdAt,updatedAt\n"))
+					} else {
+						assert.True(t, strings.HasPrefix(recorder.Body.String(), "PK"))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestExportUserChunks(t *testing.T) {
+	chunkSize := repositories.ExportChunkSize
+
+	repositories.ExportChunkSize = 2
+
+	defer func() {
+		repositories.ExportChunkSize = chunkSize
+	}()
+
+	sort := []types.SortField{{Field: "name"}, {Field: "createdAt", Desc: true}}
+
+	data, err := server.Container.UserRepository.Read(context.Background(), repositories.ReadUserData{
+		Limit:                 10000,
+		Sort:                  sort,
+		DisableCalculateTotal: true,
+	})
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var expected, exported []string
+
+	for _, user := range data.Records.([]models.User) {
+		expected = append(expected, user.ID)
+	}
+
+	chunks := 0
+
+	err = server.Container.UserRepository.Export(context.Background(), repositories.ReadUserData{Sort: sort}, func(users []models.User) error {
+		chunks++
+
+		assert.LessOrEqual(t, len(users), 2)
+
+		for _, user := range users {
+			exported = append(exported, user.ID)
+		}
+
+		return nil
+	})
+
+	if assert.NoError(t, err) {
+		assert.Greater(t, chunks, 1)
+
+		assert.Equal(t, expected, exported)
+	}
+}
+
+func TestExportUserSearch(t *testing.T) {
+	data, err := server.Container.UserRepository.Read(context.Background(), repositories.ReadUserData{
+		Limit:                 10000,
+		Search:                "Unit",
+		Cursor:                true,
+		DisableCalculateTotal: true,
+	})
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var expected, exported []string
+
+	for _, user := range data.Records.([]models.User) {
+		expected = append(expected, user.ID)
+	}
+
+	err = server.Container.UserRepository.Export(context.Background(), repositories.ReadUserData{Search: "Unit"}, func(users []models.User) error {
+		for _, user := range users {
+			exported = append(exported, user.ID)
+		}
+
+		return nil
+	})
+
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, exported)
+
+		assert.Equal(t, expected, exported)
+
+		assert.True(t, slices.IsSorted(exported), "Expected the Export is Ordered by ID. Actual: %v", exported)
+	}
+}
+
+func TestImportUser(t *testing.T) {
+	cases := []struct {
+		TestName   string
+		Url        string
+		File       string
+		StatusCode int
+		Data       any
+	}{
+		{
+			"Import User => Failed Validation => File (Required)",
+			"/api/v1/user/import",
+			"",
+			400,
+			map[string]any{
+				"file": "cannot be blank",
+			},
+		},
+		{
+			"Import User => Failed Validation => Dry Run (In)",
+			"/api/v1/user/import?dryRun=A",
+			"name,emails\nUnit Test,unit.test@gmail.com\n",
+			400,
+			map[string]any{
+				"dryRun": "must be a valid value",
+			},
+		},
+		{
+			"Import User => Invalid File",
+			"/api/v1/user/import",
+			"username,mail\nUnit Test,unit.test@gmail.com\n",
+			422,
+			map[string]any{
+				"error": "INVALID_IMPORT_FILE",
+			},
+		},
+		{
+			"Import User => Success => Dry Run",
+			"/api/v1/user/import?dryRun=true",
+			"name,emails\nUnit Test Import,unit.test.import@gmail.com;unit.test.import2@gmail.com\n,unit.test.import@gmail.com\nUnit Test Import,unit.test.import@gmail.com;unit.test.import@gmail.com\n",
+			200,
+			map[string]any{
+				"dryRun":  true,
+				"valid":   float64(1),
+				"created": float64(0),
+				"failed": []any{
+					map[string]any{
+						"row": float64(3),
+						"errors": map[string]any{
+							"name": "cannot be blank",
+						},
+					},
+					map[string]any{
+						"row": float64(4),
+						"errors": map[string]any{
+							"emails": "DUPLICATE_EMAIL",
+						},
+					},
+				},
+			},
+		},
+		{
+			"Import User => Email Already Taken => Dry Run",
+			"/api/v1/user/import?dryRun=true",
+			"name,emails\nUnit Test Import,mrandreid.business@gmail.com\nUnit Test Import,unit.test.import.dup." + runID + "@gmail.com\nUnit Test Import,unit.test.import.dup." + runID + "@gmail.com\n",
+			200,
+			map[string]any{
+				"dryRun":  true,
+				"valid":   float64(1),
+				"created": float64(0),
+				"failed": []any{
+					map[string]any{
+						"row": float64(2),
+						"errors": map[string]any{
+							"emails": "EMAIL_ALREADY_TAKEN",
+						},
+					},
+					map[string]any{
+						"row": float64(4),
+						"errors": map[string]any{
+							"emails": "DUPLICATE_EMAIL",
+						},
+					},
+				},
+			},
+		},
+		{
+			"Import User => Email Already Taken",
+			"/api/v1/user/import",
+			"name,emails\nUnit Test Import,mrandreid.business@gmail.com\nUnit Test Import,unit.test.import.partial." + runID + "@gmail.com\n",
+			200,
+			map[string]any{
+				"dryRun":  false,
+				"valid":   float64(1),
+				"created": float64(1),
+				"failed": []any{
+					map[string]any{
+						"row": float64(2),
+						"errors": map[string]any{
+							"emails": "EMAIL_ALREADY_TAKEN",
+						},
+					},
+				},
+			},
+		},
+		{
+			"Import User => Success",
+			"/api/v1/user/import",
+			"name,emails\nUnit Test Import,unit.test.import." + runID + "@gmail.com\n",
+			200,
+			map[string]any{
+				"dryRun":  false,
+				"valid":   float64(1),
+				"created": float64(1),
+				"failed":  []any{},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			body := new(bytes.Buffer)
+
+			writer := multipart.NewWriter(body)
+
+			if test.File != "" {
+				part, err := writer.CreateFormFile("file", "users.csv")
+
+				if assert.NoError(t, err) {
+					part.Write([]byte(test.File))
+				}
+			}
+
+			writer.Close()
+
+			request := httptest.NewRequest(http.MethodPost, test.Url, body)
+
+			request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+			recorder := httptest.NewRecorder()
+
+			c := echo.New().NewContext(request, recorder)
+
+			if assert.NoError(t, userHandlerFunc.Import(c)) {
+				assert.Equal(t, test.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Data, recorderResponse.Data)
+			}
+		})
+	}
+}
+
+func TestBulkUser(t *testing.T) {
+	var tooManyItems []string
+
+	for i := 0; i <= server.Application.Config.BulkMaxItems; i++ {
+		tooManyItems = append(tooManyItems, uuid.NewString())
+	}
+
+	strictUserHandlerFunc := handlers.NewUserHandler(echo.New().Group(""), server.Container.UserService, handlers.UserHandlerOptions{
+		RequireIfMatch: true,
+	})
+
+	user, _ := server.Container.UserService.Find(context.Background(), id)
+
+	cases := []TestCase{
+		{
+			"Bulk Create User => Failed Validation => Items (Required)",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/bulk",
+			},
+			nil,
+			map[string]any{},
+			func(c echo.Context) error {
+				return userHandlerFunc.BulkCreate(c)
+			},
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"items": "cannot be blank",
+					},
+				},
+			},
+		},
+		{
+			"Bulk Create User => Failed Validation => Atomic (In)",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/bulk?atomic=A",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"name":   "Unit Test Bulk",
+						"emails": []string{"unit.test.bulk@gmail.com"},
+					},
+				},
+			},
+			func(c echo.Context) error {
+				return userHandlerFunc.BulkCreate(c)
+			},
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"atomic": "must be a valid value",
+					},
+				},
+			},
+		},
+		{
+			"Bulk Create User => Atomic => Rolled Back",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/bulk?atomic=true",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"name":   "Unit Test Bulk",
+						"emails": []string{"unit.test.bulk@gmail.com"},
+					},
+					map[string]any{
+						"name":   "Unit Test Bulk",
+						"emails": []string{"unit.test.bulk@gmail.com", "unit.test.bulk@gmail.com"},
+					},
+				},
+			},
+			func(c echo.Context) error {
+				return userHandlerFunc.BulkCreate(c)
+			},
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "DUPLICATE_EMAIL",
+						"details": map[string]any{
+							"index": float64(1),
+						},
+					},
+				},
+			},
+		},
+		{
+			"Bulk Create User => Best Effort",
+			Request{
+				Method: http.MethodPost,
+				Url:    "/api/v1/user/bulk",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"name":   "Unit Test Bulk",
+						"emails": []string{"unit.test.bulk." + runID + "@gmail.com"},
+					},
+					map[string]any{
+						"name":   "Unit Test Bulk",
+						"emails": []string{"unit.test.bulk@gmail.com", "unit.test.bulk@gmail.com"},
+					},
+				},
+			},
+			func(c echo.Context) error {
+				return userHandlerFunc.BulkCreate(c)
+			},
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data: map[string]any{
+						"atomic":    false,
+						"succeeded": float64(1),
+						"failed":    float64(1),
+					},
+				},
+			},
+		},
+		{
+			"Bulk Delete User => Limit Exceeded",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/bulk",
+			},
+			nil,
+			map[string]any{
+				"ids": tooManyItems,
+			},
+			func(c echo.Context) error {
+				return userHandlerFunc.BulkDelete(c)
+			},
+			ExpectedResponse{
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "BULK_LIMIT_EXCEEDED",
+						"details": map[string]any{
+							"max": float64(server.Application.Config.BulkMaxItems),
+						},
+					},
+				},
+			},
+		},
+		{
+			"Bulk Update User => Best Effort => Not Found",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/bulk",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"id":   uuid.NewString(),
+						"name": "Unit Test Bulk",
+					},
+				},
+			},
+			func(c echo.Context) error {
+				return userHandlerFunc.BulkUpdate(c)
+			},
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data: map[string]any{
+						"atomic":    false,
+						"succeeded": float64(0),
+						"failed":    float64(1),
+					},
+				},
+			},
+		},
+		{
+			"Bulk Update User => Strict => Version Required",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/bulk",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"id":   id,
+						"name": "Unit Test Bulk",
+					},
+				},
+			},
+			strictUserHandlerFunc.BulkUpdate,
+			ExpectedResponse{
+				StatusCode: 428,
+				BodyPart: Response{
+					Code:        "0428",
+					Description: "PRECONDITION_REQUIRED",
+					Data: map[string]any{
+						"error": "VERSION_REQUIRED",
+						"details": map[string]any{
+							"index": float64(0),
+						},
+					},
+				},
+			},
+		},
+		{
+			"Bulk Update User => Strict => Version Mismatch",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/bulk?atomic=true",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"id":      id,
+						"name":    "Unit Test Bulk",
+						"version": 0,
+					},
+				},
+			},
+			strictUserHandlerFunc.BulkUpdate,
+			ExpectedResponse{
+				StatusCode: 412,
+				BodyPart: Response{
+					Code:        "0412",
+					Description: "PRECONDITION_FAILED",
+					Data: map[string]any{
+						"error": "VERSION_MISMATCH",
+						"details": map[string]any{
+							"index": float64(0),
+						},
+					},
+				},
+			},
+		},
+		{
+			"Bulk Update User => Strict => Success",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/bulk?atomic=true",
+			},
+			nil,
+			map[string]any{
+				"items": []any{
+					map[string]any{
+						"id":      id,
+						"name":    "Unit Test Bulk",
+						"version": user.Version,
+					},
+				},
+			},
+			strictUserHandlerFunc.BulkUpdate,
+			ExpectedResponse{
+				StatusCode: 200,
+				BodyPart: Response{
+					Code:        "0200",
+					Description: "SUCCESS",
+					Data: map[string]any{
+						"atomic":    true,
+						"succeeded": float64(1),
+						"failed":    float64(0),
+					},
+				},
+			},
+		},
+		{
+			"Bulk Delete User => Strict => Version Required",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/bulk",
+			},
+			nil,
+			map[string]any{
+				"ids": []any{id},
+			},
+			strictUserHandlerFunc.BulkDelete,
+			ExpectedResponse{
+				StatusCode: 428,
+				BodyPart: Response{
+					Code:        "0428",
+					Description: "PRECONDITION_REQUIRED",
+					Data: map[string]any{
+						"error": "VERSION_REQUIRED",
+						"details": map[string]any{
+							"index": float64(0),
+						},
+					},
+				},
+			},
+		},
+		{
+			"Bulk Delete User => Strict => Version Mismatch",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/bulk?atomic=true",
+			},
+			nil,
+			map[string]any{
+				"ids": []any{id},
+				"versions": map[string]any{
+					id: 0,
+				},
+			},
+			strictUserHandlerFunc.BulkDelete,
+			ExpectedResponse{
+				StatusCode: 412,
+				BodyPart: Response{
+					Code:        "0412",
+					Description: "PRECONDITION_FAILED",
+					Data: map[string]any{
+						"error": "VERSION_MISMATCH",
+						"details": map[string]any{
+							"index": float64(0),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.TestName, func(t *testing.T) {
+			c, recorder := PrepareContextFromTestCase(test)
+
+			if assert.NoError(t, test.HandlerFunc(c)) {
+				assert.Equal(t, test.Expected.StatusCode, recorder.Code)
+
+				var recorderResponse Response
+				json.Unmarshal(recorder.Body.Bytes(), &recorderResponse)
+
+				assert.Equal(t, test.Expected.BodyPart.Code, recorderResponse.Code)
+
+				assert.Equal(t, test.Expected.BodyPart.Description, recorderResponse.Description)
+
+				if test.Expected.StatusCode != 200 {
+					assert.Equal(t, test.Expected.BodyPart.Data, recorderResponse.Data)
+				} else {
+					data, _ := recorderResponse.Data.(map[string]any)
+
+					for key, value := range test.Expected.BodyPart.Data.(map[string]any) {
+						assert.Equal(t, value, data[key])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	headers := []Header{
+		{
+			Key:   "Content-Type",
+			Value: "application/json",
+		},
+	}
+
+	ifMatchHeaders := append([]Header{
+		{
+			Key:   "If-Match",
+			Value: `"0"`,
+		},
+	}, headers...)
+
+	strictUserHandlerFunc := handlers.NewUserHandler(echo.New().Group(""), server.Container.UserService, handlers.UserHandlerOptions{
+		RequireIfMatch: true,
+	})
+
+	var handlerFunc = func(c echo.Context) error {
+		return userHandlerFunc.Update(c)
+	}
+
+	cases := []TestCase{
+		{
+			"Update User => Failed Validation => ID (Required)",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user",
+			},
+			&headers,
+			types.UpdateUserRequest{
+				Name: "Unit Test Update",
+				Emails: []string{
+					"unit_test0_update@email.com",
+					"unit_test1_update@email.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"id": "cannot be blank",
+					},
+				},
+			},
+		},
+		{
+			"Update User => Failed Validation => ID (isUUID)",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/A",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "A",
+				},
+			},
+			&headers,
+			types.UpdateUserRequest{
+				Name: "Unit Test Update",
+				Emails: []string{
+					"unit_test0_update@email.com",
+					"unit_test1_update@email.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"id": "must be a valid UUID",
+					},
+				},
+			},
+		},
+		{
+			"Update User => Failed Validation => Name (Blacklist => ')",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/" + id,
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: id,
+				},
+			},
+			&headers,
+			types.UpdateUserRequest{
+				Name: "Unit Test Update'",
+				Emails: []string{
+					"unit_test0_update@email.com",
+					"unit_test1_update@email.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"name": "the name contains unsafe characters",
+					},
+				},
+			},
+		},
+		{
+			`Update User => Failed Validation => Name (Blacklist => ")`,
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/" + id,
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: id,
+				},
+			},
+			&headers,
+			types.UpdateUserRequest{
+				Name: `Unit Test Update"`,
+				Emails: []string{
+					"unit_test0_update@email.com",
+					"unit_test1_update@email.com",
+				},
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 400,
+				BodyPart: Response{
+					Code:        "0400",
+					Description: "BAD_REQUEST",
+					Data: map[string]any{
+						"name": "the name contains unsafe characters",
 					},
 				},
 			},
@@ -1369,10 +2754,91 @@ func TestUpdateUser(t *testing.T) {
 			},
 			handlerFunc,
 			ExpectedResponse{
-				StatusCode: 500,
+				StatusCode: 422,
+				BodyPart: Response{
+					Code:        "0422",
+					Description: "UNPROCESSABLE_ENTITY",
+					Data: map[string]any{
+						"error": "DUPLICATE_EMAIL",
+					},
+				},
+			},
+		},
+		{
+			"Update User => Not Found",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/00000000-0000-4000-8000-000000000000",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "00000000-0000-4000-8000-000000000000",
+				},
+			},
+			&headers,
+			types.UpdateUserRequest{
+				Name: "Unit Test Update",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 404,
+				BodyPart: Response{
+					Code:        "0404",
+					Description: "NOT_FOUND",
+					Data: map[string]any{
+						"error": "USER_NOT_FOUND",
+					},
+				},
+			},
+		},
+		{
+			"Update User => Version Mismatch",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/" + id,
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: id,
+				},
+			},
+			&ifMatchHeaders,
+			types.UpdateUserRequest{
+				Name: "Unit Test Update",
+			},
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 412,
+				BodyPart: Response{
+					Code:        "0412",
+					Description: "PRECONDITION_FAILED",
+					Data: map[string]any{
+						"error": "VERSION_MISMATCH",
+					},
+				},
+			},
+		},
+		{
+			"Update User => If-Match Required",
+			Request{
+				Method: http.MethodPatch,
+				Url:    "/api/v1/user/" + id,
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: id,
+				},
+			},
+			&headers,
+			types.UpdateUserRequest{
+				Name: "Unit Test Update",
+			},
+			strictUserHandlerFunc.Update,
+			ExpectedResponse{
+				StatusCode: 428,
 				BodyPart: Response{
-					Code:        "0500",
-					Description: "INTERNAL_SERVER_ERROR",
+					Code:        "0428",
+					Description: "PRECONDITION_REQUIRED",
+					Data: map[string]any{
+						"error": "IF_MATCH_REQUIRED",
+					},
 				},
 			},
 		},
@@ -1475,6 +2941,59 @@ func TestDeleteUser(t *testing.T) {
 				},
 			},
 		},
+		{
+			"Delete User => Not Found",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/00000000-0000-4000-8000-000000000000",
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: "00000000-0000-4000-8000-000000000000",
+				},
+			},
+			nil,
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 404,
+				BodyPart: Response{
+					Code:        "0404",
+					Description: "NOT_FOUND",
+					Data: map[string]any{
+						"error": "USER_NOT_FOUND",
+					},
+				},
+			},
+		},
+		{
+			"Delete User => Version Mismatch",
+			Request{
+				Method: http.MethodDelete,
+				Url:    "/api/v1/user/" + id,
+				PathParam: &PathParam{
+					Name:  "id",
+					Value: id,
+				},
+			},
+			&[]Header{
+				{
+					Key:   "If-Match",
+					Value: `"0"`,
+				},
+			},
+			nil,
+			handlerFunc,
+			ExpectedResponse{
+				StatusCode: 412,
+				BodyPart: Response{
+					Code:        "0412",
+					Description: "PRECONDITION_FAILED",
+					Data: map[string]any{
+						"error": "VERSION_MISMATCH",
+					},
+				},
+			},
+		},
 		{
 			"Delete User => Success",
 			Request{